@@ -96,6 +96,20 @@ func (c Ctx) NotFoundError(err error, entity, key, value string) error {
 	return nil
 }
 
+// InvalidReferenceError mengubah error not found dari data yang direferensikan oleh payload menjadi bad request.
+func (c Ctx) InvalidReferenceError(err error, entity, key, value string) error {
+	if err != nil && err == gorm.ErrRecordNotFound {
+		return NewError(http.StatusBadRequest, c.Trans("invalid_reference",
+			map[string]string{
+				"entity": c.Trans(entity),
+				"key":    c.Trans(key),
+				"value":  value,
+			},
+		))
+	}
+	return err
+}
+
 func (c Ctx) Hook(method, reason, id string, old any) {
 
 	// kasih jeda 2 detik untuk memastikan db transaction nya sudah di commit
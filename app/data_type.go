@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -145,3 +146,15 @@ func NewNullUUID(val ...string) NullUUID {
 type NullJSON struct {
 	grest.NullJSON
 }
+
+// Unmarshal parses the JSON value of NullJSON and stores the result in the value pointed to by v, it does nothing when the value is null.
+func (n NullJSON) Unmarshal(v any) error {
+	if !n.Valid {
+		return nil
+	}
+	b, err := json.Marshal(&n)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
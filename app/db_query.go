@@ -42,6 +42,14 @@ func Find(db *gorm.DB, model ModelInterface, query url.Values) ([]map[string]any
 	return q.Find(q.Schema, query)
 }
 
+func Query(db *gorm.DB, model ModelInterface, query url.Values) (*gorm.DB, error) {
+	q := &dbQuery{}
+	q.DB = db
+	q.Schema = model.GetSchema()
+	q.Query = query
+	return q.Prepare(db, q.Schema, query)
+}
+
 func PaginationInfo(db *gorm.DB, model ModelInterface, query url.Values) (int64, int, int, int, error) {
 	var err error
 	count, page, perPage, pageCount := int64(0), 0, 0, 0
@@ -2,11 +2,98 @@ package i18n
 
 func EnUS() map[string]string {
 	return map[string]string{
-		"400_bad_request":              "The request cannot be performed because of malformed or missing parameters.",
-		"401_unauthorized":             "Unauthorized. Please Re-Login",
-		"403_forbidden":                "The user does not have permission to :action.",
-		"404_not_found":                "The resource you have specified cannot be found.",
-		"500_internal_error":           "Failed to connect to the server, please try again later.",
-		"invalid_username_or_password": "Invalid username or password",
+		"400_bad_request":                  "The request cannot be performed because of malformed or missing parameters.",
+		"401_unauthorized":                 "Unauthorized. Please Re-Login",
+		"403_forbidden":                    "The user does not have permission to :action.",
+		"404_not_found":                    "The resource you have specified cannot be found.",
+		"500_internal_error":               "Failed to connect to the server, please try again later.",
+		"invalid_username_or_password":     "Invalid username or password",
+		"invalid_question_type":            "The question type :type is not supported, use one of :types.",
+		"answer_choice_required":           "The answer of :type question must select a choice.",
+		"answer_choice_not_allowed":        "The answer of :type question must not select a choice.",
+		"answer_text_required":             "The answer of :type question must not be empty.",
+		"answer_number_invalid":            "The answer must be a valid number.",
+		"answer_date_invalid":              "The answer must be a valid date with format YYYY-MM-DD.",
+		"answer_rating_invalid":            "The answer must be a whole number between :min and :max.",
+		"answer_file_extension_invalid":    "The uploaded file must have one of the following extensions: :extensions.",
+		"question_not_in_survey":           "The question :question_id does not belong to the survey.",
+		"choice_not_in_question":           "The choice :choise_id does not belong to the question :question_id.",
+		"answer_duplicated":                "The question :question_id accepts only one answer.",
+		"reference_required":               "The :key is required.",
+		"invalid_reference":                "The :entity with :key :value does not exist or has been deleted.",
+		"invalid_question_validation":      "The question validation is invalid: :message.",
+		"invalid_answers":                  "Some of the answers are invalid, please check the detail of each question.",
+		"question_required":                "This question is required.",
+		"answer_min_length":                "The answer must be at least :min characters.",
+		"answer_max_length":                "The answer may not be greater than :max characters.",
+		"answer_pattern_mismatch":          "The answer format is invalid.",
+		"answer_min":                       "The answer must be at least :min.",
+		"answer_max":                       "The answer may not be greater than :max.",
+		"answer_min_selected":              "Select at least :min choices.",
+		"answer_max_selected":              "Select at most :max choices.",
+		"answer_min_date":                  "The answer must be a date after or equal to :min.",
+		"answer_max_date":                  "The answer must be a date before or equal to :max.",
+		"invalid_reorder":                  "The :entity to reorder must contain every :entity of the :parent exactly once.",
+		"section_not_in_survey":            "The section :section_id does not belong to the survey.",
+		"question_not_in_section":          "The question :question_id does not belong to the submitted page.",
+		"response_already_completed":       "The response is already completed.",
+		"invalid_logic":                    "The logic is invalid: :message.",
+		"section_hidden":                   "The section :section_id is skipped by the answers of the previous pages.",
+		"invalid_question_code":            "The question code :code may only contain letters, numbers, dashes and underscores.",
+		"question_code_duplicated":         "The question code :code is already used on the survey.",
+		"draft":                            "draft",
+		"published":                        "published",
+		"closed":                           "closed",
+		"archived":                         "archived",
+		"publish":                          "publish",
+		"close":                            "close",
+		"archive":                          "archive",
+		"reopen":                           "reopen",
+		"survey_not_open":                  "The survey is not accepting responses because it is :status.",
+		"survey_not_editable":              "The survey is :status, revise it to draft to change its sections, questions and choices.",
+		"invalid_status_transition":        "The survey can not :action because it is :status.",
+		"survey_without_question":          "The survey must have at least one question to be published.",
+		"survey_closed":                    "The survey is closed and no longer accepts responses.",
+		"survey_not_started":               "The survey is not accepting responses until :opens_at.",
+		"invalid_survey_schedule":          "The closing time of the survey must be after its opening time.",
+		"invalid_max_responses":            "The maximum number of responses must be at least 1.",
+		"survey_versions":                  "survey version",
+		"number":                           "number",
+		"invalid_version_number":           "The version number :number is invalid.",
+		"invalid_structure":                "The survey structure is invalid: :message.",
+		"survey_templates":                 "survey template",
+		"template_code_duplicated":         "The template code :code is already used.",
+		"bank_questions":                   "bank question",
+		"invalid_bank_question_choices":    "The choices of the bank question are invalid: :message.",
+		"invalid_bank_question_tags":       "The tags of the bank question must be a list of text.",
+		"bank_question_code_duplicated":    "The bank question code :code is already used.",
+		"question_rows":                    "question row",
+		"answer_row_required":              "The answer of :type question must refer to a row.",
+		"answer_row_not_allowed":           "The answer of :type question can not refer to a row.",
+		"row_not_in_question":              "The row :row_id does not belong to the question :question_id.",
+		"answer_row_duplicated":            "The row :row_id is answered more than once.",
+		"matrix_rows_required":             "All of the rows of this question must be answered.",
+		"invalid_bank_question_rows":       "The rows of the bank question are invalid: :message.",
+		"result_filter_question_not_found": "The question :question of the answer filter is not found in the survey.",
+		"answer_rank_required":             "The answer of :type question must have a rank starting from 1.",
+		"answer_rank_not_allowed":          "The answer of :type question can not have a rank.",
+		"answer_choice_duplicated":         "The choice :choise_id is answered more than once.",
+		"answer_rank_invalid":              "The rank :rank must be unique and between 1 and :max.",
+		"ranking_choices_required":         "All of the choices of this question must be ranked.",
+		"crosstab_question_not_found":      "The question :question of the crosstab is not found in the survey.",
+		"crosstab_question_unsupported":    "The question :question of :type type can not be used on the crosstab, use a choice or numeric question.",
+		"export_format_unsupported":        "The export format :format is not supported, use one of :formats.",
+		"import_definition_invalid":        "The survey definition is invalid: :message.",
+		"import_format_unknown":            "The format of the survey definition is unknown, specify one of :formats.",
+		"import_format_unsupported":        "The import format :format is not supported, use one of :formats.",
+		"import_untitled":                  "Imported survey",
+		"invalid_definition":               "The survey definition is invalid: :message.",
+		"invalid_translations":             "The translations are invalid: :message.",
+		"questions":                        "question",
+		"id":                               "id",
+		"sections":                         "section",
+		"choices":                          "choice",
+		"revise":                           "revise",
+		"export_queue_full":                "There are too many exports in progress, please try again later.",
 	}
 }
@@ -2,11 +2,98 @@ package i18n
 
 func IdID() map[string]string {
 	return map[string]string{
-		"400_bad_request":              "Permintaan tidak dapat dilakukan karena ada parameter yang salah atau tidak lengkap.",
-		"401_unauthorized":             "Token otentikasi tidak valid. Silakan logout dan login ulang",
-		"403_forbidden":                "Pengguna tidak memiliki izin untuk :action.",
-		"404_not_found":                "The resource you have specified cannot be found.",
-		"500_internal_error":           "Gagal terhubung ke server, silakan coba lagi nanti.",
-		"invalid_username_or_password": "Username atau kata sandi tidak valid",
+		"400_bad_request":                  "Permintaan tidak dapat dilakukan karena ada parameter yang salah atau tidak lengkap.",
+		"401_unauthorized":                 "Token otentikasi tidak valid. Silakan logout dan login ulang",
+		"403_forbidden":                    "Pengguna tidak memiliki izin untuk :action.",
+		"404_not_found":                    "The resource you have specified cannot be found.",
+		"500_internal_error":               "Gagal terhubung ke server, silakan coba lagi nanti.",
+		"invalid_username_or_password":     "Username atau kata sandi tidak valid",
+		"invalid_question_type":            "Tipe pertanyaan :type tidak didukung, gunakan salah satu dari :types.",
+		"answer_choice_required":           "Jawaban untuk pertanyaan :type harus memilih salah satu pilihan.",
+		"answer_choice_not_allowed":        "Jawaban untuk pertanyaan :type tidak boleh memilih pilihan.",
+		"answer_text_required":             "Jawaban untuk pertanyaan :type tidak boleh kosong.",
+		"answer_number_invalid":            "Jawaban harus berupa angka yang valid.",
+		"answer_date_invalid":              "Jawaban harus berupa tanggal yang valid dengan format YYYY-MM-DD.",
+		"answer_rating_invalid":            "Jawaban harus berupa bilangan bulat antara :min dan :max.",
+		"answer_file_extension_invalid":    "File yang diunggah harus memiliki salah satu ekstensi berikut: :extensions.",
+		"question_not_in_survey":           "Pertanyaan :question_id bukan bagian dari survei.",
+		"choice_not_in_question":           "Pilihan :choise_id bukan bagian dari pertanyaan :question_id.",
+		"answer_duplicated":                "Pertanyaan :question_id hanya menerima satu jawaban.",
+		"reference_required":               ":key wajib diisi.",
+		"invalid_reference":                ":entity dengan :key :value tidak ditemukan atau sudah dihapus.",
+		"invalid_question_validation":      "Validasi pertanyaan tidak valid: :message.",
+		"invalid_answers":                  "Beberapa jawaban tidak valid, silakan periksa detail setiap pertanyaan.",
+		"question_required":                "Pertanyaan ini wajib dijawab.",
+		"answer_min_length":                "Jawaban minimal :min karakter.",
+		"answer_max_length":                "Jawaban maksimal :max karakter.",
+		"answer_pattern_mismatch":          "Format jawaban tidak valid.",
+		"answer_min":                       "Jawaban minimal :min.",
+		"answer_max":                       "Jawaban maksimal :max.",
+		"answer_min_selected":              "Pilih minimal :min pilihan.",
+		"answer_max_selected":              "Pilih maksimal :max pilihan.",
+		"answer_min_date":                  "Jawaban harus berupa tanggal setelah atau sama dengan :min.",
+		"answer_max_date":                  "Jawaban harus berupa tanggal sebelum atau sama dengan :max.",
+		"invalid_reorder":                  ":entity yang diurutkan harus berisi setiap :entity dari :parent tepat satu kali.",
+		"section_not_in_survey":            "Bagian :section_id bukan bagian dari survei.",
+		"question_not_in_section":          "Pertanyaan :question_id bukan bagian dari halaman yang dikirim.",
+		"response_already_completed":       "Respons sudah selesai diisi.",
+		"invalid_logic":                    "Logika tidak valid: :message.",
+		"section_hidden":                   "Bagian :section_id dilewati berdasarkan jawaban pada halaman sebelumnya.",
+		"invalid_question_code":            "Kode pertanyaan :code hanya boleh berisi huruf, angka, tanda hubung dan garis bawah.",
+		"question_code_duplicated":         "Kode pertanyaan :code sudah digunakan pada survei.",
+		"draft":                            "draf",
+		"published":                        "terbit",
+		"closed":                           "ditutup",
+		"archived":                         "diarsipkan",
+		"publish":                          "diterbitkan",
+		"close":                            "ditutup",
+		"archive":                          "diarsipkan",
+		"reopen":                           "dibuka kembali",
+		"survey_not_open":                  "Survei tidak menerima respons karena berstatus :status.",
+		"survey_not_editable":              "Survei berstatus :status, revisi menjadi draf untuk mengubah bagian, pertanyaan dan pilihannya.",
+		"invalid_status_transition":        "Survei tidak dapat :action karena berstatus :status.",
+		"survey_without_question":          "Survei harus memiliki minimal satu pertanyaan untuk diterbitkan.",
+		"survey_closed":                    "Survei sudah ditutup dan tidak lagi menerima respons.",
+		"survey_not_started":               "Survei belum menerima respons hingga :opens_at.",
+		"invalid_survey_schedule":          "Waktu penutupan survei harus setelah waktu pembukaannya.",
+		"invalid_max_responses":            "Jumlah maksimal respons minimal 1.",
+		"survey_versions":                  "versi survei",
+		"number":                           "nomor",
+		"invalid_version_number":           "Nomor versi :number tidak valid.",
+		"invalid_structure":                "Struktur survei tidak valid: :message.",
+		"survey_templates":                 "templat survei",
+		"template_code_duplicated":         "Kode templat :code sudah digunakan.",
+		"bank_questions":                   "bank pertanyaan",
+		"invalid_bank_question_choices":    "Pilihan bank pertanyaan tidak valid: :message.",
+		"invalid_bank_question_tags":       "Tag bank pertanyaan harus berupa daftar teks.",
+		"bank_question_code_duplicated":    "Kode bank pertanyaan :code sudah digunakan.",
+		"question_rows":                    "baris pertanyaan",
+		"answer_row_required":              "Jawaban pertanyaan :type harus merujuk ke sebuah baris.",
+		"answer_row_not_allowed":           "Jawaban pertanyaan :type tidak boleh merujuk ke baris.",
+		"row_not_in_question":              "Baris :row_id bukan bagian dari pertanyaan :question_id.",
+		"answer_row_duplicated":            "Baris :row_id dijawab lebih dari sekali.",
+		"matrix_rows_required":             "Semua baris pada pertanyaan ini harus dijawab.",
+		"invalid_bank_question_rows":       "Baris bank pertanyaan tidak valid: :message.",
+		"result_filter_question_not_found": "Pertanyaan :question pada filter jawaban tidak ditemukan di survei.",
+		"answer_rank_required":             "Jawaban pertanyaan :type harus memiliki peringkat mulai dari 1.",
+		"answer_rank_not_allowed":          "Jawaban pertanyaan :type tidak boleh memiliki peringkat.",
+		"answer_choice_duplicated":         "Pilihan :choise_id dijawab lebih dari sekali.",
+		"answer_rank_invalid":              "Peringkat :rank harus unik dan antara 1 sampai :max.",
+		"ranking_choices_required":         "Semua pilihan pada pertanyaan ini harus diberi peringkat.",
+		"crosstab_question_not_found":      "Pertanyaan :question pada tabulasi silang tidak ditemukan di survei.",
+		"crosstab_question_unsupported":    "Pertanyaan :question dengan tipe :type tidak dapat digunakan pada tabulasi silang, gunakan pertanyaan pilihan atau angka.",
+		"export_format_unsupported":        "Format ekspor :format tidak didukung, gunakan salah satu dari :formats.",
+		"import_definition_invalid":        "Definisi survei tidak valid: :message.",
+		"import_format_unknown":            "Format definisi survei tidak diketahui, tentukan salah satu dari :formats.",
+		"import_format_unsupported":        "Format impor :format tidak didukung, gunakan salah satu dari :formats.",
+		"import_untitled":                  "Survei hasil impor",
+		"invalid_definition":               "Definisi survei tidak valid: :message.",
+		"invalid_translations":             "Terjemahan tidak valid: :message.",
+		"questions":                        "pertanyaan",
+		"id":                               "id",
+		"sections":                         "bagian",
+		"choices":                          "pilihan",
+		"revise":                           "direvisi",
+		"export_queue_full":                "Terlalu banyak ekspor yang sedang diproses, silakan coba lagi nanti.",
 	}
 }
@@ -5,14 +5,17 @@ import "github.com/survey-app/survey/app"
 // Answer is the main model of Answer data. It provides a convenient interface for app.ModelInterface
 type Answer struct {
 	app.Model
-	ID         app.NullUUID     `json:"id"          db:"m.id"          gorm:"column:id;primaryKey"`
-	ResponseId app.NullUUID     `json:"response_id" db:"m.response_id" gorm:"column:response_id"`
-	QuestionId app.NullUUID     `json:"question_id" db:"m.question_id" gorm:"column:question_id"`
-	ChoiseId   app.NullUUID     `json:"choise_id"   db:"m.choise_id"   gorm:"column:choise_id"`
-	AnswerText app.NullText     `json:"answer_text" db:"m.answer_text" gorm:"column:answer_text"`
-	CreatedAt  app.NullDateTime `json:"created_at"  db:"m.created_at"  gorm:"column:created_at"`
-	UpdatedAt  app.NullDateTime `json:"updated_at"  db:"m.updated_at"  gorm:"column:updated_at"`
-	DeletedAt  app.NullDateTime `json:"deleted_at"  db:"m.deleted_at"  gorm:"column:deleted_at"`
+	ID             app.NullUUID     `json:"id"               db:"m.id"               gorm:"column:id;primaryKey"`
+	ResponseId     app.NullUUID     `json:"response_id"      db:"m.response_id"      gorm:"column:response_id"`
+	QuestionId     app.NullUUID     `json:"question_id"      db:"m.question_id"      gorm:"column:question_id"`
+	ChoiseId       app.NullUUID     `json:"choise_id"        db:"m.choise_id"        gorm:"column:choise_id"`
+	RowId          app.NullUUID     `json:"row_id"           db:"m.row_id"           gorm:"column:row_id"`
+	Rank           app.NullInt64    `json:"rank"             db:"m.rank"             gorm:"column:rank"`
+	AnswerText     app.NullText     `json:"answer_text"      db:"m.answer_text"      gorm:"column:answer_text"`
+	CreatedAt      app.NullDateTime `json:"created_at"       db:"m.created_at"       gorm:"column:created_at"`
+	UpdatedAt      app.NullDateTime `json:"updated_at"       db:"m.updated_at"       gorm:"column:updated_at"`
+	DeletedAt      app.NullDateTime `json:"deleted_at"       db:"m.deleted_at"       gorm:"column:deleted_at"`
+	BankQuestionId app.NullUUID     `json:"bank_question_id" db:"q.bank_question_id" gorm:"-"`
 }
 
 // EndPoint returns the Answer end point, it used for cache key, etc.
@@ -23,7 +26,7 @@ func (Answer) EndPoint() string {
 // TableVersion returns the versions of the Answer table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Answer) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291200"
 }
 
 // TableName returns the name of the Answer table in the database.
@@ -38,6 +41,7 @@ func (Answer) TableAliasName() string {
 
 // GetRelations returns the relations of the Answer data in the database, used for querying.
 func (m *Answer) GetRelations() map[string]map[string]any {
+	m.AddRelation("left", "questions", "q", []map[string]any{{"column1": "q.id", "column2": "m.question_id"}})
 	// m.AddRelation("left", "users", "cu", []map[string]any{{"column1": "cu.id", "column2": "m.created_by_user_id"}})
 	// m.AddRelation("left", "users", "uu", []map[string]any{{"column1": "uu.id", "column2": "m.updated_by_user_id"}})
 	return m.Relations
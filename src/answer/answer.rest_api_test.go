@@ -68,6 +68,15 @@ var tests = []struct {
 		expectedCode: http.StatusCreated,
 		expectedBody: `{"name":"Kilogram"}`,
 	},
+	{
+		description:  "Create Answer with unknown reference",
+		method:       "POST",
+		path:         "/answers",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"response_id":"00000000-0000-0000-0000-000000000000","question_id":"00000000-0000-0000-0000-000000000000","answer_text":"Yes"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
 	{
 		description:  "Get Answer by ID",
 		method:       "GET",
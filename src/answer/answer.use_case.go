@@ -6,6 +6,11 @@ import (
 	"time"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/choice"
+	"github.com/survey-app/survey/src/question"
+	"github.com/survey-app/survey/src/response"
+	"github.com/survey-app/survey/src/row"
+	"github.com/survey-app/survey/src/survey"
 )
 
 // UseCase returns a UseCaseHandler for expected use case functional.
@@ -143,6 +148,12 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 		return err
 	}
 
+	// validate the answer against the survey definition
+	err = u.validateAnswer(p.Answer, Answer{})
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -190,6 +201,12 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 		return err
 	}
 
+	// validate the answer against the survey definition
+	err = u.validateAnswer(p.Answer, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -237,6 +254,12 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 		return err
 	}
 
+	// validate the answer against the survey definition
+	err = u.validateAnswer(p.Answer, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -308,3 +331,109 @@ func (u *UseCaseHandler) setDefaultValue(old Answer) error {
 
 	return nil
 }
+
+// validateAnswer validates that the referenced response, question, choice and row exist and are not deleted,
+// the question belongs to the survey of the response, the choice and the row belong to the question and the answer fits the question type.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateAnswer(p, old Answer) error {
+	responseID := p.ResponseId
+	if !responseID.Valid {
+		responseID = old.ResponseId
+	}
+	questionID := p.QuestionId
+	if !questionID.Valid {
+		questionID = old.QuestionId
+	}
+	choiseID := p.ChoiseId
+	if !choiseID.Valid {
+		choiseID = old.ChoiseId
+	}
+	rowID := p.RowId
+	if !rowID.Valid {
+		rowID = old.RowId
+	}
+	rank := p.Rank
+	if !rank.Valid {
+		rank = old.Rank
+	}
+	answerText := p.AnswerText
+	if !answerText.Valid {
+		answerText = old.AnswerText
+	}
+
+	if !responseID.Valid {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "response_id"}))
+	}
+	if !questionID.Valid {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "question_id"}))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	r := response.Response{}
+	err = app.First(tx, &r, url.Values{"id": []string{responseID.String}})
+	if err != nil {
+		return u.Ctx.InvalidReferenceError(err, r.EndPoint(), "id", responseID.String)
+	}
+
+	q := question.Question{}
+	err = app.First(tx, &q, url.Values{"id": []string{questionID.String}})
+	if err != nil {
+		return u.Ctx.InvalidReferenceError(err, q.EndPoint(), "id", questionID.String)
+	}
+	if q.SurveyId.String != r.SurveyId.String {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("question_not_in_survey", map[string]string{
+			"question_id": q.ID.String,
+		}))
+	}
+
+	if choiseID.Valid {
+		c := choice.Choice{}
+		err = app.First(tx, &c, url.Values{"id": []string{choiseID.String}})
+		if err != nil {
+			return u.Ctx.InvalidReferenceError(err, c.EndPoint(), "id", choiseID.String)
+		}
+		if c.QuestionId.String != q.ID.String {
+			return app.NewError(http.StatusBadRequest, u.Ctx.Trans("choice_not_in_question", map[string]string{
+				"choise_id":   c.ID.String,
+				"question_id": q.ID.String,
+			}))
+		}
+	}
+
+	if rowID.Valid {
+		r := row.Row{}
+		err = app.First(tx, &r, url.Values{"id": []string{rowID.String}})
+		if err != nil {
+			return u.Ctx.InvalidReferenceError(err, r.EndPoint(), "id", rowID.String)
+		}
+		if r.QuestionId.String != q.ID.String {
+			return app.NewError(http.StatusBadRequest, u.Ctx.Trans("row_not_in_question", map[string]string{
+				"row_id":      r.ID.String,
+				"question_id": q.ID.String,
+			}))
+		}
+	}
+
+	err = survey.ValidateAnswer(u.Ctx, q.Type.String, q.Config, choiseID.String, answerText.String)
+	if err != nil {
+		return err
+	}
+	err = survey.ValidateAnswerRow(u.Ctx, q.Type.String, rowID.String)
+	if err != nil {
+		return err
+	}
+	err = survey.ValidateAnswerRank(u.Ctx, q.Type.String, rank.Int64)
+	if err != nil {
+		return err
+	}
+	v, err := survey.ParseQuestionValidation(q.Validation)
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	return v.ValidateAnswerText(u.Ctx, q.Type.String, answerText.String)
+}
@@ -0,0 +1,2 @@
+// bank is a package related to the question bank data, the reusable question definitions shared across surveys.
+package bank
@@ -0,0 +1,393 @@
+package bank
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Question use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Question
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Question data for the specified ID.
+func (u UseCaseHandler) GetByID(id string) (Question, error) {
+	res := Question{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "." + id
+	app.Cache().Get(cacheKey, &res)
+	if res.ID.Valid {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	key := "id"
+	if !app.Validator().IsValid(id, "uuid") {
+		key = "code"
+	}
+	u.Query.Add(key, id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), key, id)
+	}
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Get returns the list of Question data.
+func (u UseCaseHandler) Get() (app.ListModel, error) {
+	res := app.ListModel{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.list")
+	if err != nil {
+		return res, err
+	}
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "?" + u.Query.Encode()
+	err = app.Cache().Get(cacheKey, &res)
+	if err == nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// set pagination info
+	res.Count,
+		res.PageContext.Page,
+		res.PageContext.PerPage,
+		res.PageContext.PageCount,
+		err = app.PaginationInfo(tx, &Question{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	// return data count if $per_page set to 0
+	if res.PageContext.PerPage == 0 {
+		return res, err
+	}
+
+	// find data
+	data, err := app.Find(tx, &Question{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	res.SetData(data, u.Query)
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Create creates a new data Question with specified parameters.
+func (u UseCaseHandler) Create(p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(Question{})
+	if err != nil {
+		return err
+	}
+
+	// validate the definition and the code of the bank question
+	err = u.validateQuestion(p.Question, Question{})
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// save data to db
+	err = tx.Model(&p).Create(&p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "create", p.ID.String, p)
+	return nil
+}
+
+// UpdateByID updates the Question data for the specified ID with specified parameters.
+func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the definition and the code of the bank question
+	err = u.validateQuestion(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// PartiallyUpdateByID updates the Question data for the specified ID with specified parameters.
+func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the definition and the code of the bank question
+	err = u.validateQuestion(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// DeleteByID deletes the Question data for the specified ID.
+func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("bank_questions.delete")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Update("deleted_at", time.Now().UTC()).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// setDefaultValue set default value of undefined field when create or update Question data.
+func (u *UseCaseHandler) setDefaultValue(old Question) error {
+	if !old.ID.Valid {
+		u.ID = app.NewNullUUID()
+	} else {
+		u.ID = old.ID
+	}
+
+	if !old.ID.Valid && !u.Type.Valid {
+		u.Type.Set(survey.QuestionTypeSingleChoice)
+	}
+
+	return nil
+}
+
+// validateQuestion validates the required question text, the definition and the unique code of the bank question.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateQuestion(p, old Question) error {
+	questionText := p.QuestionText
+	if !questionText.Valid {
+		questionText = old.QuestionText
+	}
+	if !questionText.Valid || questionText.String == "" {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "question_text"}))
+	}
+
+	// validate the validation rules of the question
+	err := survey.ValidateQuestionValidation(u.Ctx, p.Validation)
+	if err != nil {
+		return err
+	}
+
+	// validate the choices, the rows and the tags
+	_, err = survey.ParseBankChoices(p.Choices)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_bank_question_choices", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	_, err = survey.ParseBankRows(p.Rows)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_bank_question_rows", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	tags := []string{}
+	err = p.Tags.Unmarshal(&tags)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_bank_question_tags"))
+	}
+
+	if !p.Code.Valid || p.Code.String == old.Code.String {
+		return nil
+	}
+	if !survey.IsValidQuestionCode(p.Code.String) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_question_code", map[string]string{"code": p.Code.String}))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	count := int64(0)
+	err = tx.Model(&Question{}).Where("code = ? AND deleted_at IS NULL", p.Code).Count(&count).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if count > 0 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("bank_question_code_duplicated", map[string]string{"code": p.Code.String}))
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ type Choice struct {
 	ID         app.NullUUID     `json:"id"          db:"m.id"          gorm:"column:id;primaryKey"`
 	QuestionId app.NullUUID     `json:"question_id" db:"m.question_id" gorm:"column:question_id"`
 	ChoiseText app.NullText     `json:"choise_text" db:"m.choise_text" gorm:"column:choise_text"`
+	Position   app.NullInt64    `json:"position"    db:"m.position"    gorm:"column:position"`
 	CreatedAt  app.NullDateTime `json:"created_at"  db:"m.created_at"  gorm:"column:created_at"`
 	UpdatedAt  app.NullDateTime `json:"updated_at"  db:"m.updated_at"  gorm:"column:updated_at"`
 	DeletedAt  app.NullDateTime `json:"deleted_at"  db:"m.deleted_at"  gorm:"column:deleted_at"`
@@ -21,7 +22,7 @@ func (Choice) EndPoint() string {
 // TableVersion returns the versions of the Choice table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Choice) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291220"
 }
 
 // TableName returns the name of the Choice table in the database.
@@ -49,6 +50,7 @@ func (m *Choice) GetFilters() []map[string]any {
 
 // GetSorts returns the default sort of the Choice data in the database, used for querying.
 func (m *Choice) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.position", "direction": "asc"})
 	m.AddSort(map[string]any{"column": "m.updated_at", "direction": "desc"})
 	return m.Sorts
 }
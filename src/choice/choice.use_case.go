@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
 )
 
 // UseCase returns a UseCaseHandler for expected use case functional.
@@ -143,12 +144,31 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 		return err
 	}
 
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Choice, Choice{})
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
 		return app.NewError(http.StatusInternalServerError, err.Error())
 	}
 
+	// put the new choice at the end of the question when the position is undefined
+	if !p.Position.Valid {
+		maxPosition := int64(0)
+		err = tx.Model(&Choice{}).
+			Where("question_id = ? AND deleted_at IS NULL", p.QuestionId).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&maxPosition).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		p.Position.Set(maxPosition + 1)
+	}
+
 	// save data to db
 	err = tx.Model(&p).Create(&p).Error
 	if err != nil {
@@ -157,6 +177,7 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint())
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("POST", "create", p.ID.String, p)
@@ -190,6 +211,12 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 		return err
 	}
 
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Choice, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -204,6 +231,7 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
@@ -237,6 +265,12 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 		return err
 	}
 
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Choice, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -251,6 +285,7 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
@@ -278,6 +313,12 @@ func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
 		return err
 	}
 
+	// the structure of the published survey is not editable
+	err = u.validateEditable(Choice{}, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -292,6 +333,7 @@ func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
@@ -308,3 +350,15 @@ func (u *UseCaseHandler) setDefaultValue(old Choice) error {
 
 	return nil
 }
+
+// validateEditable validates that the survey of the question of the choice, before and after the change, is still editable.
+func (u UseCaseHandler) validateEditable(p, old Choice) error {
+	err := survey.ValidateQuestionEditable(u.Ctx, old.QuestionId.String)
+	if err != nil {
+		return err
+	}
+	if p.QuestionId.Valid && p.QuestionId.String != old.QuestionId.String {
+		return survey.ValidateQuestionEditable(u.Ctx, p.QuestionId.String)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package export
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// Column is a column of the export, either a field of the response or (a part of) the answer of a question.
+type Column struct {
+	Key      string          // the key of the column, also the object key on jsonl
+	Label    string          // the header of the column on csv and xlsx
+	Field    string          // the field of the response column
+	Question survey.Question // the question of the answer column
+	ChoiseID string          // the choice of the column of the multiple choice or the ranking question
+	RowID    string          // the row of the column of the matrix question
+}
+
+// ResponseFields returns the fields of the response written before the answers.
+func ResponseFields() []string {
+	return []string{"response_id", "respondent_name", "respondent_email", "created_at", "completed_at"}
+}
+
+// Columns returns the columns of the export of the survey s, the response fields followed by the questions ordered by position.
+// The multiple choice and the ranking question are expanded into a column per choice, and the matrix question into a column per row.
+// The key of the question column is the code of the question, or its id when the code is not set.
+func Columns(s survey.Survey) []Column {
+	res := []Column{}
+	for _, f := range ResponseFields() {
+		res = append(res, Column{Key: f, Label: f, Field: f})
+	}
+
+	questions := append([]survey.Question{}, s.Questions...)
+	sort.SliceStable(questions, func(i, j int) bool {
+		return questions[i].Position.Int64 < questions[j].Position.Int64
+	})
+	for _, q := range questions {
+		key := q.ID.String
+		if q.Code.Valid && q.Code.String != "" {
+			key = q.Code.String
+		}
+		switch q.Type.String {
+		case survey.QuestionTypeMultipleChoice, survey.QuestionTypeRanking:
+			for i, c := range q.Choises {
+				res = append(res, Column{
+					Key:      key + "." + strconv.Itoa(i+1),
+					Label:    q.QuestionText.String + " [" + c.ChoiseText.String + "]",
+					Question: q,
+					ChoiseID: c.ID.String,
+				})
+			}
+		case survey.QuestionTypeMatrix:
+			for i, r := range q.Rows {
+				res = append(res, Column{
+					Key:      key + "." + strconv.Itoa(i+1),
+					Label:    q.QuestionText.String + " [" + r.RowText.String + "]",
+					Question: q,
+					RowID:    r.ID.String,
+				})
+			}
+		default:
+			res = append(res, Column{Key: key, Label: q.QuestionText.String, Question: q})
+		}
+	}
+	return res
+}
+
+// IsNumeric reports whether the value of the column is a number, the multiple choice column is 1 when the choice is selected,
+// 0 when other choice is selected, and empty when the question is skipped.
+func (c Column) IsNumeric() bool {
+	if c.Field != "" {
+		return false
+	}
+	switch c.Question.Type.String {
+	case survey.QuestionTypeNumber, survey.QuestionTypeRating, survey.QuestionTypeNPS,
+		survey.QuestionTypeMultipleChoice, survey.QuestionTypeRanking:
+		return true
+	}
+	return false
+}
+
+// Value returns the value of the column of the response r, it is empty when the question is skipped.
+func (c Column) Value(r Response) string {
+	switch c.Field {
+	case "response_id":
+		return r.ID.String
+	case "respondent_name":
+		return r.RespondentName.String
+	case "respondent_email":
+		return r.RespondentEmail.String
+	case "created_at":
+		return formatTime(r.CreatedAt.Valid, r.CreatedAt.Time)
+	case "completed_at":
+		return formatTime(r.CompletedAt.Valid, r.CompletedAt.Time)
+	}
+
+	answers := []Answer{}
+	for _, a := range r.Answers {
+		if a.QuestionID.String == c.Question.ID.String {
+			answers = append(answers, a)
+		}
+	}
+	if len(answers) == 0 {
+		return ""
+	}
+
+	values := []string{}
+	for _, a := range answers {
+		switch {
+		case c.Question.Type.String == survey.QuestionTypeMultipleChoice:
+			if a.ChoiseID.String == c.ChoiseID {
+				return "1"
+			}
+		case c.Question.Type.String == survey.QuestionTypeRanking:
+			if a.ChoiseID.String == c.ChoiseID && a.Rank.Valid {
+				return strconv.FormatInt(a.Rank.Int64, 10)
+			}
+		case c.RowID != "" && a.RowID.String != c.RowID:
+			// the answer of the other row of the matrix question
+		case a.ChoiseID.Valid:
+			values = append(values, c.choiceText(a.ChoiseID.String))
+		default:
+			values = append(values, a.AnswerText.String)
+		}
+	}
+	if c.Question.Type.String == survey.QuestionTypeMultipleChoice {
+		return "0"
+	}
+	return strings.Join(values, ", ")
+}
+
+// IsCoded reports whether the value of the column is a single choice, written as its code on the formats having value labels.
+func (c Column) IsCoded() bool {
+	if c.Field != "" || len(c.Question.Choises) == 0 {
+		return false
+	}
+	switch c.Question.Type.String {
+	case survey.QuestionTypeMultipleChoice, survey.QuestionTypeRanking:
+		return false
+	}
+	return true
+}
+
+// Code returns the value of the column of the response r, the coded column has the code of the selected choice instead of its text,
+// the code is the order of the choice starting from 1.
+func (c Column) Code(r Response) string {
+	if !c.IsCoded() {
+		return c.Value(r)
+	}
+	for _, a := range r.Answers {
+		if a.QuestionID.String != c.Question.ID.String || (c.RowID != "" && a.RowID.String != c.RowID) {
+			continue
+		}
+		for i, choise := range c.Question.Choises {
+			if choise.ID.String == a.ChoiseID.String {
+				return strconv.Itoa(i + 1)
+			}
+		}
+	}
+	return ""
+}
+
+// choiceText returns the text of the choice of the question of the column, it returns the id of the unknown choice.
+func (c Column) choiceText(id string) string {
+	for _, choise := range c.Question.Choises {
+		if choise.ID.String == id {
+			return choise.ChoiseText.String
+		}
+	}
+	return id
+}
+
+// formatTime returns the time t in RFC3339 format on UTC, it returns empty string when the time is not valid.
+func formatTime(isValid bool, t time.Time) string {
+	if !isValid {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
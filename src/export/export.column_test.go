@@ -0,0 +1,95 @@
+package export
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// testSurvey returns a survey with a multiple choice, a matrix, a ranking and a text question, added in reverse position.
+func testSurvey() survey.Survey {
+	s := survey.Survey{}
+	for i, typ := range []string{survey.QuestionTypeLongText, survey.QuestionTypeRanking, survey.QuestionTypeMatrix, survey.QuestionTypeMultipleChoice} {
+		q := survey.Question{}
+		q.ID.Set(typ)
+		q.Type.Set(typ)
+		q.QuestionText.Set(typ)
+		q.Position.Set(int64(4 - i))
+		for _, id := range []string{"a", "b"} {
+			c := survey.Choise{}
+			c.ID.Set(typ + "." + id)
+			c.ChoiseText.Set(id)
+			q.Choises = append(q.Choises, c)
+			if typ == survey.QuestionTypeMatrix {
+				r := survey.Row{}
+				r.ID.Set("row." + id)
+				r.RowText.Set("row " + id)
+				q.Rows = append(q.Rows, r)
+			}
+		}
+		if typ == survey.QuestionTypeLongText {
+			q.Code.Set("feedback")
+			q.Choises = nil
+		}
+		s.Questions = append(s.Questions, q)
+	}
+	return s
+}
+
+// testResponse returns a response answering the multiple choice, the matrix and the ranking question of the testSurvey.
+func testResponse() Response {
+	r := Response{}
+	r.ID.Set("r1")
+	r.CreatedAt.Set(time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC))
+	for _, a := range [][]string{
+		{survey.QuestionTypeMultipleChoice, "multiple_choice.b", ""},
+		{survey.QuestionTypeMatrix, "matrix.a", "row.b"},
+		{survey.QuestionTypeRanking, "ranking.a", "", "2"},
+		{survey.QuestionTypeRanking, "ranking.b", "", "1"},
+	} {
+		answer := Answer{}
+		answer.QuestionID.Set(a[0])
+		answer.ChoiseID.Set(a[1])
+		if a[2] != "" {
+			answer.RowID.Set(a[2])
+		}
+		if len(a) > 3 {
+			rank, _ := strconv.ParseInt(a[3], 10, 64)
+			answer.Rank.Set(rank)
+		}
+		r.Answers = append(r.Answers, answer)
+	}
+	return r
+}
+
+func TestColumns(t *testing.T) {
+	columns := Columns(testSurvey())
+	expected := []string{
+		"response_id", "respondent_name", "respondent_email", "created_at", "completed_at",
+		"multiple_choice.1", "multiple_choice.2", "matrix.1", "matrix.2", "ranking.1", "ranking.2", "feedback",
+	}
+	if len(columns) != len(expected) {
+		t.Fatalf("expected %d columns, got %d", len(expected), len(columns))
+	}
+	for i, c := range columns {
+		if c.Key != expected[i] {
+			t.Errorf("expected column %d to be %s, got %s", i, expected[i], c.Key)
+		}
+	}
+	if columns[6].Label != "multiple_choice [b]" || columns[8].Label != "matrix [row b]" {
+		t.Errorf("expected the label with the choice or the row, got %s and %s", columns[6].Label, columns[8].Label)
+	}
+
+	values := []string{}
+	for _, c := range columns {
+		values = append(values, c.Value(testResponse()))
+	}
+	expected = []string{"r1", "", "", "2026-10-18T09:30:00Z", "", "0", "1", "", "a", "2", "1", ""}
+	for i, v := range values {
+		if v != expected[i] {
+			t.Errorf("expected the value of %s to be %q, got %q", columns[i].Key, expected[i], v)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// export is a package related to the export of the survey responses, a wide table with a row per response and a column per question.
+// The export is either streamed directly or built by a background job and stored on the app.FS.
+package export
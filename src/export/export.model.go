@@ -0,0 +1,191 @@
+package export
+
+import (
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+// Job is the main model of Export data, an export of the survey responses built in the background.
+// The file is uploaded to the app.FS, and it is deleted by RunSchedule when the job is expired.
+// It provides a convenient interface for app.ModelInterface
+type Job struct {
+	app.Model
+	ID          app.NullUUID     `json:"id"           db:"m.id"              gorm:"column:id;primaryKey"`
+	SurveyID    app.NullUUID     `json:"survey_id"    db:"m.survey_id"       gorm:"column:survey_id"`
+	Format      app.NullString   `json:"format"       db:"m.format"          gorm:"column:format"`
+	Status      app.NullString   `json:"status"       db:"m.status"          gorm:"column:status"`
+	FileName    app.NullString   `json:"file_name"    db:"m.file_name"       gorm:"column:file_name"`
+	FileSize    app.NullInt64    `json:"file_size"    db:"m.file_size"       gorm:"column:file_size"`
+	Error       app.NullText     `json:"error"        db:"m.error"           gorm:"column:error"`
+	DownloadURL app.NullString   `json:"download_url" db:"-"                 gorm:"-"`
+	StartedAt   app.NullDateTime `json:"started_at"   db:"m.started_at"      gorm:"column:started_at"`
+	CompletedAt app.NullDateTime `json:"completed_at" db:"m.completed_at"    gorm:"column:completed_at"`
+	ExpiresAt   app.NullDateTime `json:"expires_at"   db:"m.expires_at"      gorm:"column:expires_at"`
+	CreatedAt   app.NullDateTime `json:"created_at"   db:"m.created_at"      gorm:"column:created_at"`
+	UpdatedAt   app.NullDateTime `json:"updated_at"   db:"m.updated_at"      gorm:"column:updated_at"`
+	DeletedAt   app.NullDateTime `json:"deleted_at"   db:"m.deleted_at,hide" gorm:"column:deleted_at"`
+}
+
+// EndPoint returns the Export end point, it used for cache key, etc.
+func (Job) EndPoint() string {
+	return "exports"
+}
+
+// TableVersion returns the versions of the exports table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Job) TableVersion() string {
+	return "26.10.181700"
+}
+
+// TableName returns the name of the exports table in the database.
+func (Job) TableName() string {
+	return "exports"
+}
+
+// TableAliasName returns the table alias name of the exports table, used for querying.
+func (Job) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the Export data in the database, used for querying.
+func (m *Job) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the Export data in the database, used for querying.
+func (m *Job) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the Export data in the database, used for querying.
+func (m *Job) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.created_at", "direction": "desc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the Export data in the database, used for querying.
+func (m *Job) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the Export schema, used for querying.
+func (m *Job) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the Export schema in the open api documentation.
+func (Job) OpenAPISchemaName() string {
+	return "Export"
+}
+
+// The statuses of the export Job, the pending job is waiting for the worker and the expired job has its file deleted.
+const (
+	JobStatusPending    = "pending"
+	JobStatusProcessing = "processing"
+	JobStatusCompleted  = "completed"
+	JobStatusFailed     = "failed"
+	JobStatusExpired    = "expired"
+)
+
+const (
+	// JobExpiration is how long the file of the completed export Job can be downloaded before it is deleted.
+	JobExpiration = 24 * time.Hour
+
+	// JobTimeout is how long the export Job can be pending or processing, the job exceeding it is considered
+	// interrupted (e.g. by the restart of the server) and it is marked as failed.
+	JobTimeout = time.Hour
+)
+
+// ObjectName returns the name of the file of the export Job on the app.FS.
+func (m Job) ObjectName() string {
+	return "export-" + m.ID.String + "." + Extension(m.Format.String)
+}
+
+// ParamCreate is the expected parameters for create a new Export data, the format is csv when it is undefined.
+type ParamCreate struct {
+	UseCaseHandler
+}
+
+// The supported export formats.
+const (
+	FormatCSV   = "csv"
+	FormatXLSX  = "xlsx"
+	FormatJSONL = "jsonl"
+	FormatSSS   = "sss"
+)
+
+// Formats returns the list of supported export formats.
+func Formats() []string {
+	return []string{FormatCSV, FormatXLSX, FormatJSONL, FormatSSS}
+}
+
+// IsValidFormat reports whether f is one of the supported export formats.
+func IsValidFormat(f string) bool {
+	for _, format := range Formats() {
+		if format == f {
+			return true
+		}
+	}
+	return false
+}
+
+// ContentType returns the content type of the file of the export format f.
+func ContentType(f string) string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatSSS:
+		return "application/zip"
+	}
+	return "application/octet-stream"
+}
+
+// Extension returns the extension of the file of the export format f, the Triple-S export is a zip of the metadata and the data file.
+func Extension(f string) string {
+	if f == FormatSSS {
+		return "zip"
+	}
+	return f
+}
+
+// Response is the response of the survey along with its answers, a row of the export.
+type Response struct {
+	ID              app.NullUUID     `json:"id"`
+	RespondentName  app.NullString   `json:"respondent_name"`
+	RespondentEmail app.NullString   `json:"respondent_email"`
+	SurveyVersionId app.NullUUID     `json:"survey_version_id"`
+	CreatedAt       app.NullDateTime `json:"created_at"`
+	CompletedAt     app.NullDateTime `json:"completed_at"`
+	Answers         []Answer         `json:"answers"`
+}
+
+// Answer is the answer of the Response.
+type Answer struct {
+	QuestionID app.NullUUID  `json:"question_id"`
+	ChoiseID   app.NullUUID  `json:"choise_id"`
+	RowID      app.NullUUID  `json:"row_id"`
+	Rank       app.NullInt64 `json:"rank"`
+	AnswerText app.NullText  `json:"answer_text"`
+}
+
+// record is a row of the responses joined with their answers, the response without answer has a single record without answer.
+type record struct {
+	ID              app.NullUUID     `gorm:"column:id"`
+	RespondentName  app.NullString   `gorm:"column:respondent_name"`
+	RespondentEmail app.NullString   `gorm:"column:respondent_email"`
+	SurveyVersionId app.NullUUID     `gorm:"column:survey_version_id"`
+	CreatedAt       app.NullDateTime `gorm:"column:created_at"`
+	CompletedAt     app.NullDateTime `gorm:"column:completed_at"`
+	QuestionID      app.NullUUID     `gorm:"column:question_id"`
+	ChoiseID        app.NullUUID     `gorm:"column:choise_id"`
+	RowID           app.NullUUID     `gorm:"column:row_id"`
+	Rank            app.NullInt64    `gorm:"column:rank"`
+	AnswerText      app.NullText     `gorm:"column:answer_text"`
+}
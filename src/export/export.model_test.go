@@ -0,0 +1,12 @@
+package export
+
+import "testing"
+
+func TestJobObjectName(t *testing.T) {
+	j := Job{}
+	j.ID.Set("00000000-0000-0000-0000-000000000001")
+	j.Format.Set(FormatXLSX)
+	if name := j.ObjectName(); name != "export-00000000-0000-0000-0000-000000000001.xlsx" {
+		t.Errorf("expected the file name with the id and the format, got %s", name)
+	}
+}
@@ -0,0 +1,88 @@
+package export
+
+import "github.com/survey-app/survey/app"
+
+// OpenAPI is constructor for *openAPI, to autogenerate open api document.
+func OpenAPI() *OpenAPIOperation {
+	return &OpenAPIOperation{}
+}
+
+// OpenAPIOperation embed from app.OpenAPIOperation for simplicity, used for autogenerate open api document.
+type OpenAPIOperation struct {
+	app.OpenAPIOperation
+}
+
+// Base is common detail of exports open api document component.
+func (o *OpenAPIOperation) Base() {
+	o.Tags = []string{"Export"}
+	o.HeaderParams = []map[string]any{{"$ref": "#/components/parameters/headerParam.Accept-Language"}}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Job{}}, // will auto create schema $ref: '#/components/schemas/Export' if not exists
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	o.Securities = []map[string][]string{}
+}
+
+// Download is detail of `GET /api/v1/surveys/{id}/export` open api document component.
+func (o *OpenAPIOperation) Download() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Export Survey Responses"
+	o.Description = "Use this method to download the responses of the Survey by id as a wide table, a row per response and a column per question ordered by position. " +
+		"The multiple choice and the ranking question have a column per choice, and the matrix question has a column per row. " +
+		"The sss format is a zip of the Triple-S XML metadata and the fixed width data file, with the choice written as its code. " +
+		"The file is streamed, so it is not limited by the number of responses"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Responses["200"] = map[string]any{
+		"description": "Success",
+		"content": map[string]any{
+			ContentType(FormatCSV):   map[string]any{"schema": map[string]any{"type": "string", "format": "binary"}},
+			ContentType(FormatXLSX):  map[string]any{"schema": map[string]any{"type": "string", "format": "binary"}},
+			ContentType(FormatJSONL): map[string]any{"schema": map[string]any{"type": "string", "format": "binary"}},
+			ContentType(FormatSSS):   map[string]any{"schema": map[string]any{"type": "string", "format": "binary"}},
+		},
+	}
+	o.QueryParams = []map[string]any{
+		{"name": "format", "in": "query", "description": "The format of the file, the default is csv", "schema": map[string]any{"type": "string", "enum": Formats()}},
+	}
+	return o
+}
+
+// Create is detail of `POST /api/v1/surveys/{id}/exports` open api document component.
+func (o *OpenAPIOperation) Create() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Create Survey Export"
+	o.Description = "Use this method to export the responses of the Survey by id in the background, the format is csv when it is undefined. " +
+		"Poll the export by its id until the status is completed, then download the file from its download_url before it is expired. " +
+		"The export is rejected with 503 when there are too many exports in progress"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamCreate{}}
+	o.Responses["202"] = o.Responses["200"]
+	delete(o.Responses, "200")
+	return o
+}
+
+// GetByID is detail of `GET /api/v1/exports/{id}` open api document component.
+func (o *OpenAPIOperation) GetByID() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Export By ID"
+	o.Description = "Use this method to get the status of the Export by id, along with the download_url when it is completed"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	return o
+}
@@ -0,0 +1,37 @@
+package export
+
+import "sync"
+
+const (
+	// JobWorkers is the number of the export jobs built at the same time.
+	JobWorkers = 2
+
+	// JobQueueSize is the number of the export jobs waiting for a worker, the export is rejected when the queue is full.
+	JobQueueSize = 100
+)
+
+var (
+	queue     chan func()
+	queueOnce sync.Once
+)
+
+// enqueue adds the task to the queue of the export workers, the workers are started on the first call.
+// It returns false without waiting when the queue is full.
+func enqueue(task func()) bool {
+	queueOnce.Do(func() {
+		queue = make(chan func(), JobQueueSize)
+		for i := 0; i < JobWorkers; i++ {
+			go func() {
+				for t := range queue {
+					t()
+				}
+			}()
+		}
+	})
+	select {
+	case queue <- task:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"bufio"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"grest.dev/grest"
+
+	"github.com/survey-app/survey/app"
+)
+
+// REST returns a *RESTAPIHandler.
+func REST() *RESTAPIHandler {
+	return &RESTAPIHandler{}
+}
+
+// RESTAPIHandler provides a convenient interface for Export REST API handler.
+type RESTAPIHandler struct {
+	UseCase UseCaseHandler
+}
+
+// injectDeps inject the dependencies of the Export REST API handler.
+func (r *RESTAPIHandler) injectDeps(c *fiber.Ctx) error {
+	ctx, ok := c.Locals(app.CtxKey).(*app.Ctx)
+	if !ok {
+		return app.NewError(http.StatusInternalServerError, "ctx is not found")
+	}
+	r.UseCase = UseCase(*ctx, app.ParseQuery(c))
+	return nil
+}
+
+// Download is the REST API handler for `GET /api/v1/surveys/{id}/export?format=csv|xlsx|jsonl`.
+// The file is streamed, so the error occurred while writing the file can only be logged.
+func (r *RESTAPIHandler) Download(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	format := c.Query("format", FormatCSV)
+	s, err := r.UseCase.Survey(c.Params("id"), format)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	c.Attachment("survey-" + s.ID.String + "." + Extension(format))
+	c.Set(fiber.HeaderContentType, ContentType(format))
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// the stream writer runs after the handler returns, when the transaction of the request is already committed,
+		// so the responses are read with the autocommit connection instead
+		err := r.UseCase.Async(*r.UseCase.Ctx).Write(s, format, w)
+		if err != nil {
+			app.Logger().Error().Err(err).Send()
+		}
+	})
+	return nil
+}
+
+// Create is the REST API handler for `POST /api/v1/surveys/{id}/exports`.
+func (r *RESTAPIHandler) Create(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := ParamCreate{}
+	err = grest.NewJSON(c.Body()).ToFlat().Unmarshal(&p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	err = r.UseCase.Create(c.Params("id"), &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.Status(http.StatusAccepted).JSON(p.Job)
+}
+
+// GetByID is the REST API handler for `GET /api/v1/exports/{id}`.
+func (r *RESTAPIHandler) GetByID(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.GetByID(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
@@ -0,0 +1,148 @@
+package export
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2/utils"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"exports.create",
+		"exports.detail",
+		"surveys.detail",
+	}))
+	app.Server().AddRoute("/surveys/:id/export", "GET", REST().Download, nil)
+	app.Server().AddRoute("/surveys/:id/exports", "POST", REST().Create, nil)
+	app.Server().AddRoute("/exports/:id", "GET", REST().GetByID, nil)
+}
+
+// getTestSurveyID returns an available Survey ID.
+func getTestSurveyID() string {
+	return "todo"
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+
+		description:  "Export responses of unknown Survey",
+		method:       "GET",
+		path:         "/surveys/00000000-0000-0000-0000-000000000000/export?format=csv",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusNotFound,
+		expectedBody: `{"error":{"code":404}}`,
+	},
+	{
+		description:  "Export Survey responses with unsupported format",
+		method:       "GET",
+		path:         "/surveys/" + getTestSurveyID() + "/export?format=pdf",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Create Export of Survey responses with unsupported format",
+		method:       "POST",
+		path:         "/surveys/" + getTestSurveyID() + "/exports",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"format":"pdf"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Get unknown Export",
+		method:       "GET",
+		path:         "/exports/00000000-0000-0000-0000-000000000000",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusNotFound,
+		expectedBody: `{"error":{"code":404}}`,
+	},
+}
+
+// TestExportREST tests the REST API of Export data with specified scenario.
+func TestExportREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
+
+// TestExportDownloadREST tests the responses of the Survey are streamed on the download, after the handler returns.
+func TestExportDownloadREST(t *testing.T) {
+	prepareTest(t)
+	tx := app.Test().Tx
+
+	// the survey along with a question and a response answering it
+	now := time.Now().UTC()
+	s := survey.Survey{}
+	s.ID = app.NewNullUUID()
+	s.Title.Set("Download")
+	s.Status.Set(survey.StatusPublished)
+	q := survey.Question{}
+	q.ID = app.NewNullUUID()
+	q.SurveyID = s.ID
+	q.QuestionText.Set("Favorite color")
+	q.Type.Set(survey.QuestionTypeShortText)
+	q.Position.Set(1)
+	responseID := app.NewNullUUID()
+	utils.AssertEqual(t, nil, tx.Create(&s).Error, "Create Survey")
+	utils.AssertEqual(t, nil, tx.Create(&q).Error, "Create Question")
+	utils.AssertEqual(t, nil, tx.Table("responses").Create(map[string]any{
+		"id": responseID, "survey_id": s.ID, "respondent_name": "Alice", "created_at": now,
+	}).Error, "Create Response")
+	utils.AssertEqual(t, nil, tx.Table("answers").Create(map[string]any{
+		"id": app.NewNullUUID(), "response_id": responseID, "question_id": q.ID, "answer_text": "Blue", "created_at": now,
+	}).Error, "Create Answer")
+
+	req := httptest.NewRequest("GET", "/surveys/"+s.ID.String+"/export?format=csv", nil)
+	req.Header.Add("Authorization", "Bearer "+app.TestFullAccessToken)
+	res, err := app.Server().Test(req)
+	utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+	defer res.Body.Close()
+	utils.AssertEqual(t, http.StatusOK, res.StatusCode, "Download Survey responses")
+	body, err := io.ReadAll(res.Body)
+	utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	utils.AssertEqual(t, 2, len(lines), "Download Survey responses has a header and a row")
+	utils.AssertEqual(t, true, strings.HasSuffix(lines[0], ",Favorite color"), "Download Survey responses header")
+	utils.AssertEqual(t, true, strings.HasPrefix(lines[1], responseID.String+",Alice,") && strings.HasSuffix(lines[1], ",Blue"), "Download Survey responses row")
+}
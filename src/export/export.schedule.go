@@ -0,0 +1,61 @@
+package export
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// RunSchedule deletes the file of the expired export jobs and fails the interrupted ones, it is registered on the scheduler to run every minute.
+func RunSchedule() {
+	tx, err := app.DB().Conn("main")
+	if err == nil {
+		err = runSchedule(tx, app.FS(), time.Now().UTC())
+	}
+	if err != nil {
+		app.Logger().Error().Err(err).Send()
+	}
+}
+
+// runSchedule applies the scheduled export job actions at now.
+func runSchedule(tx *gorm.DB, fs app.FSInterface, now time.Time) error {
+
+	// fail the jobs exceeding the timeout, the worker is interrupted (e.g. by the restart of the server)
+	err := tx.Model(&Job{}).
+		Where("deleted_at IS NULL AND status IN ? AND created_at <= ?", []string{JobStatusPending, JobStatusProcessing}, now.Add(-JobTimeout)).
+		Updates(map[string]any{
+			"status":     JobStatusFailed,
+			"error":      "The export is interrupted before it is completed.",
+			"updated_at": now,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	// expire the completed jobs, their file is deleted so it can not be downloaded anymore
+	jobs := []Job{}
+	err = tx.Model(&Job{}).Select("id", "file_name").
+		Where("deleted_at IS NULL AND status = ? AND expires_at <= ?", JobStatusCompleted, now).
+		Find(&jobs).Error
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		if j.FileName.Valid {
+			err = fs.Delete(j.FileName.String)
+			if err != nil {
+				app.Logger().Error().Err(err).Str("export_id", j.ID.String).Msg("Failed to delete the expired export file.")
+			}
+		}
+		err = tx.Model(&Job{}).Where("id = ?", j.ID).Updates(map[string]any{
+			"status":     JobStatusExpired,
+			"updated_at": now,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package export
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2/utils"
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// fakeFS is the app.FSInterface keeping the uploaded files in memory.
+type fakeFS struct {
+	files    map[string]int64
+	deleted  []string
+	err      error  // the error of the upload
+	onUpload func() // called before the file is uploaded
+}
+
+func (f *fakeFS) GetFileUrl(fileName string, path ...string) string {
+	return "/" + fileName
+}
+
+func (f *fakeFS) Upload(fileName string, src io.Reader, fileSize int64, opts ...app.FileUploadOption) (app.FileUploadInfo, error) {
+	if f.onUpload != nil {
+		f.onUpload()
+	}
+	if f.err != nil {
+		return app.FileUploadInfo{}, f.err
+	}
+	b, err := io.ReadAll(src)
+	if err != nil {
+		return app.FileUploadInfo{}, err
+	}
+	if f.files == nil {
+		f.files = map[string]int64{}
+	}
+	f.files[fileName] = int64(len(b))
+	return app.FileUploadInfo{}, nil
+}
+
+func (f *fakeFS) Delete(fileName string, opts ...app.FileDeleteOption) error {
+	f.deleted = append(f.deleted, fileName)
+	return nil
+}
+
+// createTestJob saves a new export Job with the status, created at the created time.
+func createTestJob(tb testing.TB, tx *gorm.DB, status string, created time.Time) Job {
+	j := Job{}
+	j.ID = app.NewNullUUID()
+	j.SurveyID = app.NewNullUUID()
+	j.Format.Set(FormatCSV)
+	j.Status.Set(status)
+	j.CreatedAt.Set(created)
+	j.UpdatedAt.Set(created)
+	utils.AssertEqual(tb, nil, tx.Create(&j).Error, "Create Job")
+	return j
+}
+
+// getTestJob returns the saved export Job for the specified ID.
+func getTestJob(tb testing.TB, tx *gorm.DB, id app.NullUUID) Job {
+	j := Job{}
+	utils.AssertEqual(tb, nil, tx.Where("id = ?", id).Take(&j).Error, "Get Job")
+	return j
+}
+
+func TestRunSchedule(t *testing.T) {
+	tx := app.Test().Tx
+	now := time.Now().UTC()
+	fs := &fakeFS{}
+
+	expired := createTestJob(t, tx, JobStatusCompleted, now.Add(-25*time.Hour))
+	utils.AssertEqual(t, nil, tx.Model(&Job{}).Where("id = ?", expired.ID).Updates(map[string]any{
+		"file_name": expired.ObjectName(), "expires_at": now.Add(-time.Hour),
+	}).Error, "Expire Job")
+	completed := createTestJob(t, tx, JobStatusCompleted, now.Add(-time.Minute))
+	utils.AssertEqual(t, nil, tx.Model(&Job{}).Where("id = ?", completed.ID).Updates(map[string]any{
+		"file_name": completed.ObjectName(), "expires_at": now.Add(JobExpiration),
+	}).Error, "Complete Job")
+	interrupted := createTestJob(t, tx, JobStatusProcessing, now.Add(-JobTimeout-time.Minute))
+	waiting := createTestJob(t, tx, JobStatusPending, now.Add(-JobTimeout-time.Minute))
+	pending := createTestJob(t, tx, JobStatusPending, now.Add(-time.Minute))
+
+	utils.AssertEqual(t, nil, runSchedule(tx, fs, now), "runSchedule")
+
+	// the expired job has its file deleted, the job exceeding the timeout is failed and the others are untouched
+	utils.AssertEqual(t, JobStatusExpired, getTestJob(t, tx, expired.ID).Status.String, "Expired Job")
+	utils.AssertEqual(t, []string{expired.ObjectName()}, fs.deleted, "Expired Job file is deleted")
+	utils.AssertEqual(t, JobStatusCompleted, getTestJob(t, tx, completed.ID).Status.String, "Completed Job")
+	utils.AssertEqual(t, JobStatusFailed, getTestJob(t, tx, interrupted.ID).Status.String, "Interrupted Job")
+	utils.AssertEqual(t, JobStatusFailed, getTestJob(t, tx, waiting.ID).Status.String, "Job waiting too long for a worker")
+	utils.AssertEqual(t, JobStatusPending, getTestJob(t, tx, pending.ID).Status.String, "Pending Job")
+}
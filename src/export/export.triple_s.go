@@ -0,0 +1,390 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// The types of the Triple-S variable.
+const (
+	sssTypeSingle    = "single"
+	sssTypeMultiple  = "multiple"
+	sssTypeQuantity  = "quantity"
+	sssTypeCharacter = "character"
+)
+
+// sssWriter writes the export as Triple-S 2.0, a zip of the survey.sss XML metadata and the survey.asc fixed width data file,
+// which can be opened by SPSS, R (foreign, sss) and most of the other survey analysis tools.
+// The question text is the label of the variable, the choice text is the label of its code, and the multiple choice question
+// is a multiple variable written as bitstring, a dichotomous set of a 0 or 1 digit per choice.
+// The width of the fields is only known after all of the rows are written, so the rows are spooled to a temporary file
+// and the data file is written on Close.
+type sssWriter struct {
+	zip       *zip.Writer
+	title     string
+	variables []*sssVariable
+	spool     *os.File
+	rows      *csv.Writer
+}
+
+// sssVariable is the variable of the Triple-S metadata, along with the columns of the export written on it.
+type sssVariable struct {
+	Ident    int          `xml:"ident,attr"`
+	Type     string       `xml:"type,attr"`
+	Format   string       `xml:"format,attr,omitempty"`
+	Name     string       `xml:"name"`
+	Label    string       `xml:"label"`
+	Position sssPosition  `xml:"position"`
+	Size     int          `xml:"size,omitempty"`
+	Values   *sssValueSet `xml:"values,omitempty"`
+
+	columns  []int // the indexes of the columns, a column per choice of the multiple variable
+	width    int   // the width of the character and the single variable
+	decimals int   // the number of decimals of the quantity variable
+	min, max float64
+	hasRange bool // whether the min and the max of the quantity variable are set
+}
+
+type sssPosition struct {
+	Start  int `xml:"start,attr"`
+	Finish int `xml:"finish,attr"`
+}
+
+type sssValueSet struct {
+	Range  *sssRange  `xml:"range,omitempty"`
+	Values []sssValue `xml:"value"`
+}
+
+type sssRange struct {
+	From string `xml:"from,attr"`
+	To   string `xml:"to,attr"`
+}
+
+type sssValue struct {
+	Code  string `xml:"code,attr"`
+	Label string `xml:",chardata"`
+}
+
+type sssDocument struct {
+	XMLName xml.Name `xml:"sss"`
+	Version string   `xml:"version,attr"`
+	Date    string   `xml:"date"`
+	Time    string   `xml:"time"`
+	Origin  string   `xml:"origin"`
+	Survey  struct {
+		Title  string `xml:"title"`
+		Record struct {
+			Ident     string         `xml:"ident,attr"`
+			Variables []*sssVariable `xml:"variable"`
+		} `xml:"record"`
+	} `xml:"survey"`
+}
+
+// WriteHeader prepares the variables of the columns and the temporary file of the rows.
+func (sw *sssWriter) WriteHeader(columns []Column) error {
+	sw.variables = sssVariables(columns)
+	f, err := os.CreateTemp("", "export-*.csv")
+	if err != nil {
+		return err
+	}
+	sw.spool = f
+	sw.rows = csv.NewWriter(f)
+	return nil
+}
+
+// WriteRow spools the values of a row, and widens the variables to fit the values.
+func (sw *sssWriter) WriteRow(values []string) error {
+	for _, v := range sw.variables {
+		if v.Type == sssTypeMultiple {
+			continue
+		}
+		val := ""
+		if v.columns[0] < len(values) {
+			val = values[v.columns[0]]
+		}
+		v.fit(val)
+	}
+	return sw.rows.Write(values)
+}
+
+// Close writes the metadata and the data file from the spooled rows, then removes the temporary file.
+func (sw *sssWriter) Close() error {
+	if sw.spool == nil {
+		err := sw.WriteHeader(nil)
+		if err != nil {
+			return err
+		}
+	}
+	defer os.Remove(sw.spool.Name())
+	defer sw.spool.Close()
+	sw.rows.Flush()
+	err := sw.rows.Error()
+	if err != nil {
+		return err
+	}
+
+	err = sw.writeMetadata()
+	if err != nil {
+		return err
+	}
+	err = sw.writeData()
+	if err != nil {
+		return err
+	}
+	return sw.zip.Close()
+}
+
+// writeMetadata writes the survey.sss, the variables are positioned one after another on the line of the data file.
+func (sw *sssWriter) writeMetadata() error {
+	now := time.Now().UTC()
+	doc := sssDocument{Version: "2.0", Date: now.Format("2006-01-02"), Time: now.Format("15:04"), Origin: "survey-app"}
+	doc.Survey.Title = sw.title
+	doc.Survey.Record.Ident = "A"
+	start := 1
+	for _, v := range sw.variables {
+		width := v.fieldWidth()
+		v.Position = sssPosition{Start: start, Finish: start + width - 1}
+		if v.Type == sssTypeCharacter {
+			v.Size = width
+		}
+		if v.Type == sssTypeQuantity {
+			v.Values = &sssValueSet{Range: &sssRange{From: v.format(v.min), To: v.format(v.max)}}
+		}
+		start += width
+	}
+	doc.Survey.Record.Variables = sw.variables
+
+	w, err := sw.zip.Create("survey.sss")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	return e.Encode(doc)
+}
+
+// writeData writes the survey.asc from the spooled rows, a line of fixed width fields per row.
+func (sw *sssWriter) writeData() error {
+	_, err := sw.spool.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	zw, err := sw.zip.Create("survey.asc")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(zw)
+	r := csv.NewReader(bufio.NewReader(sw.spool))
+	r.FieldsPerRecord = -1
+	for {
+		values, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, v := range sw.variables {
+			w.WriteString(v.field(values))
+		}
+		w.WriteString("\r\n")
+	}
+	return w.Flush()
+}
+
+// sssVariables returns the variables of the columns, the columns of the multiple choice question are a single multiple variable.
+// The names of the variables are unique regardless of their case, the name which is already used has a numeric suffix.
+func sssVariables(columns []Column) []*sssVariable {
+	res := []*sssVariable{}
+	used := map[string]bool{}
+	for i, c := range columns {
+		if c.Question.Type.String == survey.QuestionTypeMultipleChoice {
+			last := len(res) - 1
+			if last >= 0 && res[last].Type == sssTypeMultiple && columns[res[last].columns[0]].Question.ID.String == c.Question.ID.String {
+				res[last].columns = append(res[last].columns, i)
+				continue
+			}
+		}
+
+		v := &sssVariable{Ident: len(res) + 1, Name: sssName(c, false), Label: c.Label, columns: []int{i}}
+		switch {
+		case c.Field != "":
+			v.Type = sssTypeCharacter
+		case c.Question.Type.String == survey.QuestionTypeMultipleChoice:
+			v.Type, v.Format, v.Name, v.Label = sssTypeMultiple, "bitstring", sssName(c, true), c.Question.QuestionText.String
+		case c.IsCoded():
+			v.Type = sssTypeSingle
+		case c.Question.Type.String == survey.QuestionTypeRanking:
+			v.Type, v.min, v.max, v.hasRange = sssTypeQuantity, 1, float64(len(c.Question.Choises)), true
+		case c.Question.Type.String == survey.QuestionTypeRating:
+			v.Type = sssTypeQuantity
+			cfg, _ := survey.ParseQuestionConfig(c.Question.Config)
+			min, max := cfg.Scale()
+			v.min, v.max, v.hasRange = float64(min), float64(max), true
+		case c.Question.Type.String == survey.QuestionTypeNPS:
+			v.Type, v.min, v.max, v.hasRange = sssTypeQuantity, survey.NPSScaleMin, survey.NPSScaleMax, true
+		case c.Question.Type.String == survey.QuestionTypeNumber:
+			v.Type = sssTypeQuantity
+		default:
+			v.Type = sssTypeCharacter
+		}
+		if v.Type == sssTypeSingle || v.Type == sssTypeMultiple {
+			v.Values = &sssValueSet{}
+			for j, choise := range c.Question.Choises {
+				v.Values.Values = append(v.Values.Values, sssValue{Code: strconv.Itoa(j + 1), Label: choise.ChoiseText.String})
+			}
+			v.width = len(strconv.Itoa(len(c.Question.Choises)))
+		}
+		name := v.Name
+		for n := 2; used[strings.ToLower(v.Name)]; n++ {
+			v.Name = name + "_" + strconv.Itoa(n)
+		}
+		used[strings.ToLower(v.Name)] = true
+		res = append(res, v)
+	}
+	return res
+}
+
+// sssName returns the name of the variable of the column, which is a valid SPSS variable name. The question without code
+// is named by its position (Q1, Q2, ...), and the column of a choice or a row has the order of the choice or the row as suffix,
+// except the multiple variable (isSet) which has the columns of all of the choices.
+func sssName(c Column, isSet bool) string {
+	name := c.Field
+	if name == "" {
+		name = c.Question.Code.String
+		if name == "" {
+			name = "Q" + strconv.FormatInt(c.Question.Position.Int64, 10)
+		}
+		if !isSet && (c.ChoiseID != "" || c.RowID != "") {
+			name += "_" + c.Key[strings.LastIndex(c.Key, ".")+1:]
+		}
+	}
+	res := []rune{}
+	for i, r := range name {
+		isLetter := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		if i == 0 && !isLetter {
+			res = append(res, 'V')
+		}
+		if !isLetter && (r < '0' || r > '9') {
+			r = '_'
+		}
+		res = append(res, r)
+	}
+	return string(res)
+}
+
+// fit widens the variable to fit the value v.
+func (v *sssVariable) fit(val string) {
+	switch v.Type {
+	case sssTypeCharacter:
+		if n := utf8.RuneCountInString(val); n > v.width {
+			v.width = n
+		}
+	case sssTypeQuantity:
+		val = strings.TrimSpace(val)
+		f, err := strconv.ParseFloat(val, 64)
+		if val == "" || err != nil || !isNumber(val) {
+			return
+		}
+		if i := strings.IndexAny(val, "eE"); i < 0 {
+			if i = strings.Index(val, "."); i >= 0 && len(val)-i-1 > v.decimals {
+				v.decimals = len(val) - i - 1
+			}
+		}
+		if !v.hasRange {
+			v.min, v.max, v.hasRange = f, f, true
+		}
+		if f < v.min {
+			v.min = f
+		}
+		if f > v.max {
+			v.max = f
+		}
+	}
+}
+
+// fieldWidth returns the width of the field of the variable on the data file.
+func (v *sssVariable) fieldWidth() int {
+	width := v.width
+	switch v.Type {
+	case sssTypeMultiple:
+		width = len(v.columns)
+	case sssTypeQuantity:
+		width = len(v.format(v.min))
+		if n := len(v.format(v.max)); n > width {
+			width = n
+		}
+	}
+	if width < 1 {
+		width = 1
+	}
+	return width
+}
+
+// format returns the quantity f with the decimals of the variable.
+func (v *sssVariable) format(f float64) string {
+	return strconv.FormatFloat(f, 'f', v.decimals, 64)
+}
+
+// field returns the field of the variable on the line of the data file of the values of a row. The character field is
+// left aligned and the other fields are right aligned, the field is blank when the question is skipped.
+func (v *sssVariable) field(values []string) string {
+	value := func(i int) string {
+		if i < len(values) {
+			return values[i]
+		}
+		return ""
+	}
+	width := v.fieldWidth()
+	switch v.Type {
+	case sssTypeMultiple:
+		res := ""
+		for _, i := range v.columns {
+			switch value(i) {
+			case "1", "0":
+				res += value(i)
+			default:
+				res += " "
+			}
+		}
+		return res
+	case sssTypeCharacter:
+		val := strings.Map(func(r rune) rune {
+			if r == '\r' || r == '\n' || r == '\t' {
+				return ' '
+			}
+			return r
+		}, value(v.columns[0]))
+		return val + strings.Repeat(" ", width-utf8.RuneCountInString(val))
+	case sssTypeQuantity:
+		val := strings.TrimSpace(value(v.columns[0]))
+		f, err := strconv.ParseFloat(val, 64)
+		if val == "" || err != nil || !isNumber(val) {
+			return strings.Repeat(" ", width)
+		}
+		val = v.format(f)
+		if len(val) > width {
+			return strings.Repeat(" ", width)
+		}
+		return strings.Repeat(" ", width-len(val)) + val
+	}
+	val := value(v.columns[0])
+	if len(val) > width {
+		val = ""
+	}
+	return strings.Repeat(" ", width-len(val)) + val
+}
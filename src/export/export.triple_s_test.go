@@ -0,0 +1,89 @@
+package export
+
+import (
+	"archive/zip"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriterSSS(t *testing.T) {
+	content := writeTest(t, FormatSSS)
+	r, err := zip.NewReader(strings.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range r.File {
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+
+	metadata := files["survey.sss"]
+	for _, expected := range []string{
+		`<sss version="2.0">`,
+		`<title>Test</title>`,
+		`<variable ident="6" type="multiple" format="bitstring">`,
+		`<name>Q1</name>`,
+		`<label>multiple_choice</label>`,
+		`<position start="26" finish="27"></position>`,
+		`<value code="2">b</value>`,
+		`<name>Q2_2</name>`,
+		`<label>matrix [row b]</label>`,
+		`<range from="1" to="2"></range>`,
+		`<name>feedback</name>`,
+	} {
+		if !strings.Contains(metadata, expected) {
+			t.Errorf("expected the metadata to contain %s, got %s", expected, metadata)
+		}
+	}
+
+	// the fields are as wide as their widest value, the matrix choice is written as its code
+	expected := "r1" + "  " + "2026-10-18T09:30:00Z" + " " + "01" + " " + "1" + "2" + "1" + " " + "\r\n"
+	if data := files["survey.asc"]; data != expected {
+		t.Errorf("expected the data %q, got %q", expected, data)
+	}
+}
+
+func TestSSSName(t *testing.T) {
+	// the text question coded as q2_1 collides with the first row of the matrix question without code at position 2
+	s := testSurvey()
+	s.Questions[0].Code.Set("q2_1")
+	names := []string{}
+	for _, v := range sssVariables(Columns(s)) {
+		names = append(names, v.Name)
+	}
+	for _, expected := range []string{"Q1", "Q2_1", "Q2_2", "q2_1_2"} {
+		found := false
+		for _, name := range names {
+			found = found || name == expected
+		}
+		if !found {
+			t.Errorf("expected the variable %s, got %v", expected, names)
+		}
+	}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[strings.ToLower(name)] {
+			t.Errorf("expected the unique variable names, got %v", names)
+		}
+		seen[strings.ToLower(name)] = true
+	}
+}
+
+func TestSSSQuantity(t *testing.T) {
+	v := &sssVariable{Type: sssTypeQuantity, columns: []int{0}}
+	for _, val := range []string{"1.5", "10", "", "abc", "-2"} {
+		v.fit(val)
+	}
+	if v.min != -2 || v.max != 10 || v.decimals != 1 || v.fieldWidth() != 4 {
+		t.Fatalf("expected the range -2.0 to 10.0 with 4 digits width, got %v to %v with %d decimals", v.min, v.max, v.decimals)
+	}
+	for val, expected := range map[string]string{"1.5": " 1.5", "10": "10.0", "": "    ", "abc": "    "} {
+		if field := v.field([]string{val}); field != expected {
+			t.Errorf("expected the field of %q to be %q, got %q", val, expected, field)
+		}
+	}
+}
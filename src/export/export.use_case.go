@@ -0,0 +1,323 @@
+package export
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Export use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Job
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Export data for the specified ID, along with the download url when the export is completed.
+// It is not cached, since the status is changed by the worker in the background.
+func (u UseCaseHandler) GetByID(id string) (Job, error) {
+	res := Job{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("exports.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	u.Query.Add("id", id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), "id", id)
+	}
+	if res.Status.String == JobStatusCompleted && res.FileName.Valid {
+		res.DownloadURL.Set(app.FS().GetFileUrl(res.FileName.String))
+	}
+	return res, err
+}
+
+// Create creates a new export Job of the responses of the survey, the file is built by the worker in the background.
+func (u UseCaseHandler) Create(surveyID string, p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("exports.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+	if p.Format.String == "" {
+		p.Format.Set(FormatCSV)
+	}
+
+	// get the survey along with its questions, choices and rows
+	s, err := u.survey(surveyID, p.Format.String)
+	if err != nil {
+		return err
+	}
+
+	// the job is saved outside of the transaction of the request, so it is visible to the worker right away
+	async := u.Async(*u.Ctx)
+	tx, err := async.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	now := time.Now().UTC()
+	p.ID = app.NewNullUUID()
+	p.SurveyID = s.ID
+	p.Status.Set(JobStatusPending)
+	p.CreatedAt.Set(now)
+	p.UpdatedAt.Set(now)
+	err = tx.Create(&p.Job).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the job waiting for a worker is failed by the schedule when it exceeds the timeout
+	if !enqueue(func() { async.run(tx, app.FS(), p.Job, s) }) {
+		err = tx.Model(&Job{}).Where("id = ?", p.ID).Updates(map[string]any{
+			"status":     JobStatusFailed,
+			"error":      "The export queue is full.",
+			"updated_at": time.Now().UTC(),
+		}).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		return app.NewError(http.StatusServiceUnavailable, u.Ctx.Trans("export_queue_full"))
+	}
+	return nil
+}
+
+// run builds the file of the export Job j and uploads it to the fs, the job is marked as failed when any error occurred.
+// The job failed by the schedule in the meantime (e.g. exceeding the timeout while waiting for a worker) is left as is.
+func (u UseCaseHandler) run(tx *gorm.DB, fs app.FSInterface, j Job, s survey.Survey) {
+	now := time.Now().UTC()
+	res := tx.Model(&Job{}).Where("id = ? AND status = ?", j.ID, JobStatusPending).Updates(map[string]any{
+		"status":     JobStatusProcessing,
+		"started_at": now,
+		"updated_at": now,
+	})
+	err := res.Error
+	if err == nil && res.RowsAffected == 0 {
+		return
+	}
+	if err == nil {
+		err = u.build(tx, fs, &j, s)
+	}
+
+	now = time.Now().UTC()
+	values := map[string]any{
+		"status":       JobStatusCompleted,
+		"file_name":    j.FileName,
+		"file_size":    j.FileSize,
+		"completed_at": now,
+		"expires_at":   now.Add(JobExpiration),
+		"updated_at":   now,
+	}
+	if err != nil {
+		app.Logger().Error().Err(err).Str("export_id", j.ID.String).Send()
+		values = map[string]any{
+			"status":     JobStatusFailed,
+			"error":      err.Error(),
+			"updated_at": now,
+		}
+	}
+	res = tx.Model(&Job{}).Where("id = ? AND status = ?", j.ID, JobStatusProcessing).Updates(values)
+	if res.Error != nil {
+		app.Logger().Error().Err(res.Error).Str("export_id", j.ID.String).Send()
+	} else if res.RowsAffected == 0 && j.FileName.Valid {
+		// the job is failed by the schedule while it is processing, so its file can not be downloaded anyway
+		err = fs.Delete(j.FileName.String)
+		if err != nil {
+			app.Logger().Error().Err(err).Str("export_id", j.ID.String).Msg("Failed to delete the file of the interrupted export.")
+		}
+	}
+}
+
+// build writes the export of the survey s to a temporary file, then uploads it to the fs.
+func (u UseCaseHandler) build(tx *gorm.DB, fs app.FSInterface, j *Job, s survey.Survey) error {
+	f, err := os.CreateTemp("", "export-*."+Extension(j.Format.String))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = u.write(tx, s, j.Format.String, f)
+	if err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = fs.Upload(j.ObjectName(), f, size)
+	if err != nil {
+		return err
+	}
+	j.FileName.Set(j.ObjectName())
+	j.FileSize.Set(size)
+	return nil
+}
+
+// Survey returns the survey for the specified ID along with its questions, choices and rows to be exported in the format.
+// It is called before Write, so the error is returned before the export starts streaming.
+func (u UseCaseHandler) Survey(id, format string) (survey.Survey, error) {
+	res := survey.Survey{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("exports.detail")
+	if err != nil {
+		return res, err
+	}
+	return u.survey(id, format)
+}
+
+// survey returns the survey for the specified ID to be exported in the format, the format is validated first.
+func (u UseCaseHandler) survey(id, format string) (survey.Survey, error) {
+	res := survey.Survey{}
+
+	// validate the format
+	if !IsValidFormat(format) {
+		return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("export_format_unsupported", map[string]string{
+			"format":  format,
+			"formats": strings.Join(Formats(), ", "),
+		}))
+	}
+
+	// get the survey along with its questions, choices and rows
+	return survey.UseCase(*u.Ctx).GetByID(id)
+}
+
+// Write writes the export of the responses of the survey s in the format to w, a row per response ordered by the creation time.
+// The responses are read from the db along with their answers row by row, so only a single response is held in memory at a time.
+func (u UseCaseHandler) Write(s survey.Survey, format string, w io.Writer) error {
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	return u.write(tx, s, format, w)
+}
+
+// write writes the export of the responses of the survey s in the format to w like Write, reading the responses from tx.
+func (u UseCaseHandler) write(tx *gorm.DB, s survey.Survey, format string, w io.Writer) error {
+	rows, err := tx.Table("responses r").
+		Select("r.id, r.respondent_name, r.respondent_email, r.survey_version_id, r.created_at, r.completed_at, "+
+			"a.question_id, a.choise_id, a.row_id, a.rank, a.answer_text").
+		Joins("LEFT JOIN answers a ON a.response_id = r.id AND a.deleted_at IS NULL").
+		Where("r.survey_id = ? AND r.deleted_at IS NULL", s.ID).
+		Order("r.created_at, r.id").
+		Rows()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	defer rows.Close()
+
+	columns := Columns(s)
+	ew := NewWriter(format, s.Title.String, w)
+	err = ew.WriteHeader(columns)
+	if err != nil {
+		return err
+	}
+	writeResponse := func(r Response) error {
+		values := []string{}
+		for _, c := range columns {
+			if format == FormatSSS {
+				values = append(values, c.Code(r))
+			} else {
+				values = append(values, c.Value(r))
+			}
+		}
+		return ew.WriteRow(values)
+	}
+
+	current := Response{}
+	for rows.Next() {
+		rec := record{}
+		err = tx.ScanRows(rows, &rec)
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		if rec.ID.String != current.ID.String {
+			if current.ID.Valid {
+				err = writeResponse(current)
+				if err != nil {
+					return err
+				}
+			}
+			current = Response{
+				ID:              rec.ID,
+				RespondentName:  rec.RespondentName,
+				RespondentEmail: rec.RespondentEmail,
+				SurveyVersionId: rec.SurveyVersionId,
+				CreatedAt:       rec.CreatedAt,
+				CompletedAt:     rec.CompletedAt,
+				Answers:         []Answer{},
+			}
+		}
+		if rec.QuestionID.Valid {
+			current.Answers = append(current.Answers, Answer{
+				QuestionID: rec.QuestionID,
+				ChoiseID:   rec.ChoiseID,
+				RowID:      rec.RowID,
+				Rank:       rec.Rank,
+				AnswerText: rec.AnswerText,
+			})
+		}
+	}
+	if current.ID.Valid {
+		err = writeResponse(current)
+		if err != nil {
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	return ew.Close()
+}
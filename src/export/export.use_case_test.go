@@ -0,0 +1,83 @@
+package export
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2/utils"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestEnqueue(t *testing.T) {
+	// the workers are busy until the release, so the tasks are waiting on the queue until it is full
+	release, done := make(chan bool), make(chan bool)
+	started := make(chan bool, JobWorkers)
+	for i := 0; i < JobWorkers; i++ {
+		if !enqueue(func() { started <- true; <-release }) {
+			t.Fatal("expected the task enqueued to the idle worker")
+		}
+	}
+	for i := 0; i < JobWorkers; i++ {
+		<-started
+	}
+	for i := 0; i < JobQueueSize; i++ {
+		if !enqueue(func() { done <- true }) {
+			t.Fatalf("expected the task %d enqueued to the waiting queue", i+1)
+		}
+	}
+	if enqueue(func() {}) {
+		t.Error("expected the task rejected when the queue is full")
+	}
+
+	close(release)
+	for i := 0; i < JobQueueSize; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("expected all of the waiting tasks run, got %d", i)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	tx := app.Test().Tx
+	u := UseCase(app.Ctx{Lang: "en"})
+	s := survey.Survey{}
+	s.ID = app.NewNullUUID()
+	s.Title.Set("Export")
+
+	// the completed job has its file uploaded
+	fs := &fakeFS{}
+	j := createTestJob(t, tx, JobStatusPending, time.Now().UTC())
+	u.run(tx, fs, j, s)
+	res := getTestJob(t, tx, j.ID)
+	utils.AssertEqual(t, JobStatusCompleted, res.Status.String, "Completed Job")
+	utils.AssertEqual(t, res.FileSize.Int64, fs.files[j.ObjectName()], "Completed Job file is uploaded")
+
+	// the job failed to upload its file is marked as failed along with the error
+	fs = &fakeFS{err: errors.New("the storage is unavailable")}
+	j = createTestJob(t, tx, JobStatusPending, time.Now().UTC())
+	u.run(tx, fs, j, s)
+	res = getTestJob(t, tx, j.ID)
+	utils.AssertEqual(t, JobStatusFailed, res.Status.String, "Job failed to upload")
+	utils.AssertEqual(t, "the storage is unavailable", res.Error.String, "Job failed to upload has the error")
+
+	// the job failed by the schedule before a worker picks it up is not processed
+	fs = &fakeFS{}
+	j = createTestJob(t, tx, JobStatusFailed, time.Now().UTC().Add(-JobTimeout))
+	u.run(tx, fs, j, s)
+	utils.AssertEqual(t, JobStatusFailed, getTestJob(t, tx, j.ID).Status.String, "Job failed while pending")
+	utils.AssertEqual(t, 0, len(fs.files), "Job failed while pending is not uploaded")
+
+	// the job failed by the schedule while processing is not completed, and its file is deleted
+	j = createTestJob(t, tx, JobStatusPending, time.Now().UTC())
+	fs = &fakeFS{onUpload: func() {
+		tx.Model(&Job{}).Where("id = ?", j.ID).Update("status", JobStatusFailed)
+	}}
+	u.run(tx, fs, j, s)
+	utils.AssertEqual(t, JobStatusFailed, getTestJob(t, tx, j.ID).Status.String, "Job failed while processing")
+	utils.AssertEqual(t, []string{j.ObjectName()}, fs.deleted, "Job failed while processing has its file deleted")
+}
@@ -0,0 +1,206 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Writer writes the export row by row, so the export is streamed without holding all of the responses in memory.
+type Writer interface {
+	// WriteHeader writes the header of the columns, it is called once before the rows.
+	WriteHeader(columns []Column) error
+	// WriteRow writes the values of a row, ordered the same as the columns.
+	WriteRow(values []string) error
+	// Close flushes the buffered data and finishes the file, it does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter returns the Writer of the export format to w, the title of the survey is written on the formats having metadata.
+func NewWriter(format, title string, w io.Writer) Writer {
+	switch format {
+	case FormatSSS:
+		return &sssWriter{zip: zip.NewWriter(w), title: title}
+	case FormatXLSX:
+		return &xlsxWriter{zip: zip.NewWriter(w)}
+	case FormatJSONL:
+		return &jsonlWriter{w: bufio.NewWriter(w)}
+	}
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+// csvWriter writes the export as comma separated values, the header row has the labels of the columns.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// WriteHeader writes the header of the columns.
+func (cw *csvWriter) WriteHeader(columns []Column) error {
+	labels := []string{}
+	for _, c := range columns {
+		labels = append(labels, c.Label)
+	}
+	return cw.w.Write(labels)
+}
+
+// WriteRow writes the values of a row.
+func (cw *csvWriter) WriteRow(values []string) error {
+	return cw.w.Write(values)
+}
+
+// Close flushes the buffered data.
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// jsonlWriter writes the export as JSON Lines, an object per row keyed by the keys of the columns in the order of the columns.
+// The empty value is written as null, and the value of the numeric column as number.
+type jsonlWriter struct {
+	w       *bufio.Writer
+	columns []Column
+}
+
+// WriteHeader keeps the columns, JSON Lines has no header.
+func (jw *jsonlWriter) WriteHeader(columns []Column) error {
+	jw.columns = columns
+	return nil
+}
+
+// WriteRow writes the values of a row as a JSON object on a line.
+func (jw *jsonlWriter) WriteRow(values []string) error {
+	jw.w.WriteByte('{')
+	for i, c := range jw.columns {
+		if i > 0 {
+			jw.w.WriteByte(',')
+		}
+		key, _ := json.Marshal(c.Key)
+		jw.w.Write(key)
+		jw.w.WriteByte(':')
+		switch {
+		case i >= len(values) || values[i] == "":
+			jw.w.WriteString("null")
+		case c.IsNumeric() && isNumber(values[i]):
+			jw.w.WriteString(values[i])
+		default:
+			val, _ := json.Marshal(values[i])
+			jw.w.Write(val)
+		}
+	}
+	jw.w.WriteByte('}')
+	return jw.w.WriteByte('\n')
+}
+
+// Close flushes the buffered data.
+func (jw *jsonlWriter) Close() error {
+	return jw.w.Flush()
+}
+
+// xlsxWriter writes the export as an Office Open XML workbook with a single worksheet. The worksheet is written
+// with inline strings, so the rows are streamed into the zip entry without the shared strings table.
+type xlsxWriter struct {
+	zip     *zip.Writer
+	sheet   *bufio.Writer
+	columns []Column
+}
+
+// xlsxFiles are the parts of the workbook other than the worksheet.
+var xlsxFiles = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Responses" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// WriteHeader writes the parts of the workbook, then starts the worksheet with the header of the columns.
+func (xw *xlsxWriter) WriteHeader(columns []Column) error {
+	xw.columns = columns
+	for _, f := range xlsxFiles {
+		w, err := xw.zip.Create(f.name)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, f.content)
+		if err != nil {
+			return err
+		}
+	}
+	w, err := xw.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	xw.sheet = bufio.NewWriter(w)
+	xw.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	labels := []string{}
+	for _, c := range columns {
+		labels = append(labels, c.Label)
+	}
+	return xw.writeRow(labels, false)
+}
+
+// WriteRow writes the values of a row, the value of the numeric column is written as number.
+func (xw *xlsxWriter) WriteRow(values []string) error {
+	return xw.writeRow(values, true)
+}
+
+// writeRow writes the values as a row of the worksheet.
+func (xw *xlsxWriter) writeRow(values []string, isTyped bool) error {
+	xw.sheet.WriteString("<row>")
+	for i, v := range values {
+		switch {
+		case v == "":
+			xw.sheet.WriteString("<c/>")
+		case isTyped && i < len(xw.columns) && xw.columns[i].IsNumeric() && isNumber(v):
+			xw.sheet.WriteString("<c><v>")
+			xml.EscapeText(xw.sheet, []byte(v))
+			xw.sheet.WriteString("</v></c>")
+		default:
+			xw.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+			xml.EscapeText(xw.sheet, []byte(v))
+			xw.sheet.WriteString("</t></is></c>")
+		}
+	}
+	_, err := xw.sheet.WriteString("</row>")
+	return err
+}
+
+// Close finishes the worksheet and the workbook.
+func (xw *xlsxWriter) Close() error {
+	if xw.sheet == nil {
+		err := xw.WriteHeader(xw.columns)
+		if err != nil {
+			return err
+		}
+	}
+	xw.sheet.WriteString("</sheetData></worksheet>")
+	err := xw.sheet.Flush()
+	if err != nil {
+		return err
+	}
+	return xw.zip.Close()
+}
+
+// isNumber reports whether v is a finite number in the JSON number syntax, so it can be written as number on jsonl and xlsx.
+func isNumber(v string) bool {
+	f, err := strconv.ParseFloat(v, 64)
+	return err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) && json.Valid([]byte(v))
+}
@@ -0,0 +1,81 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+// writeTest writes the testResponse of the testSurvey in the format.
+func writeTest(t *testing.T, format string) string {
+	columns := Columns(testSurvey())
+	values := []string{}
+	for _, c := range columns {
+		if format == FormatSSS {
+			values = append(values, c.Code(testResponse()))
+		} else {
+			values = append(values, c.Value(testResponse()))
+		}
+	}
+	buf := &bytes.Buffer{}
+	w := NewWriter(format, "Test", buf)
+	if err := w.WriteHeader(columns); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(values); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestWriterCSV(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(writeTest(t, FormatCSV)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a header and a row, got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "response_id,") || !strings.Contains(lines[0], ",multiple_choice [a],") {
+		t.Errorf("expected the header with the labels, got %s", lines[0])
+	}
+	if lines[1] != "r1,,,2026-10-18T09:30:00Z,,0,1,,a,2,1," {
+		t.Errorf("unexpected row %s", lines[1])
+	}
+}
+
+func TestWriterJSONL(t *testing.T) {
+	expected := `{"response_id":"r1","respondent_name":null,"respondent_email":null,"created_at":"2026-10-18T09:30:00Z","completed_at":null,` +
+		`"multiple_choice.1":0,"multiple_choice.2":1,"matrix.1":null,"matrix.2":"a","ranking.1":2,"ranking.2":1,"feedback":null}` + "\n"
+	if actual := writeTest(t, FormatJSONL); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestWriterXLSX(t *testing.T) {
+	content := writeTest(t, FormatXLSX)
+	r, err := zip.NewReader(strings.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet := ""
+	for _, f := range r.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(b)
+		}
+	}
+	if strings.Count(sheet, "<row>") != 2 {
+		t.Fatalf("expected a header and a row on the worksheet, got %s", sheet)
+	}
+	if !strings.Contains(sheet, "<c><v>2</v></c>") || !strings.Contains(sheet, `<t xml:space="preserve">r1</t>`) {
+		t.Errorf("expected the numeric and the inline string cells, got %s", sheet)
+	}
+	if len(r.File) != len(xlsxFiles)+1 {
+		t.Errorf("expected %d parts of the workbook, got %d", len(xlsxFiles)+1, len(r.File))
+	}
+}
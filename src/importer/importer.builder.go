@@ -0,0 +1,92 @@
+package importer
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// invalidCodePattern matches the characters which can not be used on the question code.
+var invalidCodePattern = regexp.MustCompile(`[^A-Za-z0-9_\-]+`)
+
+// builder builds the survey Snapshot of the imported definition along with the unsupported constructs,
+// the ids are generated and the sections, the questions, the choices and the rows are positioned in the order they are added.
+type builder struct {
+	snapshot survey.Snapshot
+	issues   []Issue
+	section  string          // the id of the current section, the section is added when the first question is added
+	codes    map[string]bool // the codes of the added questions
+	reported map[string]bool // the constructs reported once per definition
+}
+
+// newBuilder returns the builder of the survey with the title and the description.
+func newBuilder(title, description string) *builder {
+	return &builder{
+		snapshot: survey.Snapshot{Title: title, Description: description, Questions: []survey.SnapshotQuestion{}},
+		issues:   []Issue{},
+		codes:    map[string]bool{},
+		reported: map[string]bool{},
+	}
+}
+
+// unsupported reports the unsupported construct at the path of the definition.
+func (b *builder) unsupported(path, construct, message string) {
+	b.issues = append(b.issues, Issue{Path: path, Construct: construct, Message: message})
+}
+
+// unsupportedOnce reports the unsupported construct only at the first path it is found.
+func (b *builder) unsupportedOnce(path, construct, message string) {
+	if !b.reported[construct] {
+		b.reported[construct] = true
+		b.unsupported(path, construct, message)
+	}
+}
+
+// addSection adds a section (page) of the survey, the next questions are added to it.
+func (b *builder) addSection(title, description string) {
+	b.section = app.NewNullUUID().String
+	b.snapshot.Sections = append(b.snapshot.Sections, survey.SnapshotSection{
+		ID:          b.section,
+		Title:       title,
+		Description: description,
+		Position:    int64(len(b.snapshot.Sections) + 1),
+	})
+}
+
+// addQuestion adds the question q to the current section along with the choices and the rows. The name is used as the code of the
+// question, the invalid characters are replaced and the duplicated code is suffixed, so the code is valid and unique.
+func (b *builder) addQuestion(q survey.SnapshotQuestion, name string, choices, rows []string) {
+	if b.section == "" {
+		b.addSection("", "")
+	}
+	q.ID = app.NewNullUUID().String
+	q.SectionID = b.section
+	q.Position = int64(len(b.snapshot.Questions) + 1)
+	if name != "" {
+		code := invalidCodePattern.ReplaceAllString(name, "_")
+		for i := 2; b.codes[code]; i++ {
+			code = invalidCodePattern.ReplaceAllString(name, "_") + "_" + strconv.Itoa(i)
+		}
+		b.codes[code] = true
+		q.Code = code
+	}
+	for i, c := range choices {
+		q.Choices = append(q.Choices, survey.SnapshotChoice{ID: app.NewNullUUID().String, ChoiceText: c, Position: int64(i + 1)})
+	}
+	for i, r := range rows {
+		q.Rows = append(q.Rows, survey.SnapshotRow{ID: app.NewNullUUID().String, RowText: r, Position: int64(i + 1)})
+	}
+	b.snapshot.Questions = append(b.snapshot.Questions, q)
+}
+
+// rawJSON returns v encoded as json, it returns nil when v is empty.
+func rawJSON(v any) json.RawMessage {
+	res, err := json.Marshal(v)
+	if err != nil || string(res) == "{}" {
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,3 @@
+// importer is a package related to the import of the survey definition of other tools, such as SurveyJS and Google Forms,
+// into a new draft survey along with the report of the constructs which can not be imported.
+package importer
@@ -0,0 +1,231 @@
+package importer
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// GoogleForms is the Importer of the Google Forms form JSON, as returned by the forms.get method of the Google Forms API
+// (https://developers.google.com/forms/api/reference/rest/v1/forms). The page breaks are imported as sections,
+// and the grid is imported as a matrix question along with its rows.
+type GoogleForms struct{}
+
+// googleForm is the form of the Google Forms API.
+type googleForm struct {
+	FormID string `json:"formId"`
+	Info   struct {
+		Title         string `json:"title"`
+		DocumentTitle string `json:"documentTitle"`
+		Description   string `json:"description"`
+	} `json:"info"`
+	Settings struct {
+		QuizSettings struct {
+			IsQuiz bool `json:"isQuiz"`
+		} `json:"quizSettings"`
+	} `json:"settings"`
+	Items []googleFormItem `json:"items"`
+}
+
+// googleFormItem is the item of the form, only one of the item kinds is set.
+type googleFormItem struct {
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	QuestionItem *struct {
+		Question googleFormQuestion `json:"question"`
+		Image    json.RawMessage    `json:"image"`
+	} `json:"questionItem"`
+	QuestionGroupItem *struct {
+		Questions []googleFormQuestion `json:"questions"`
+		Grid      *struct {
+			Columns googleFormChoiceQuestion `json:"columns"`
+		} `json:"grid"`
+	} `json:"questionGroupItem"`
+	PageBreakItem json.RawMessage `json:"pageBreakItem"`
+	TextItem      json.RawMessage `json:"textItem"`
+	ImageItem     json.RawMessage `json:"imageItem"`
+	VideoItem     json.RawMessage `json:"videoItem"`
+}
+
+// googleFormQuestion is the question of the form, only one of the question kinds is set.
+type googleFormQuestion struct {
+	Required       bool                      `json:"required"`
+	Grading        json.RawMessage           `json:"grading"`
+	ChoiceQuestion *googleFormChoiceQuestion `json:"choiceQuestion"`
+	TextQuestion   *struct {
+		Paragraph bool `json:"paragraph"`
+	} `json:"textQuestion"`
+	ScaleQuestion *struct {
+		Low       int64  `json:"low"`
+		High      int64  `json:"high"`
+		LowLabel  string `json:"lowLabel"`
+		HighLabel string `json:"highLabel"`
+	} `json:"scaleQuestion"`
+	RatingQuestion *struct {
+		RatingScaleLevel int64 `json:"ratingScaleLevel"`
+	} `json:"ratingQuestion"`
+	DateQuestion *struct {
+		IncludeTime bool `json:"includeTime"`
+		IncludeYear bool `json:"includeYear"`
+	} `json:"dateQuestion"`
+	TimeQuestion       json.RawMessage `json:"timeQuestion"`
+	FileUploadQuestion *struct {
+		Types []string `json:"types"`
+	} `json:"fileUploadQuestion"`
+	RowQuestion *struct {
+		Title string `json:"title"`
+	} `json:"rowQuestion"`
+}
+
+// googleFormChoiceQuestion is the choice question of the form, also the columns of the grid.
+type googleFormChoiceQuestion struct {
+	Type    string `json:"type"`
+	Shuffle bool   `json:"shuffle"`
+	Options []struct {
+		Value         string `json:"value"`
+		IsOther       bool   `json:"isOther"`
+		GoToAction    string `json:"goToAction"`
+		GoToSectionID string `json:"goToSectionId"`
+	} `json:"options"`
+}
+
+// Detect implements Importer, the Google Forms form has the items along with the form id or the info.
+func (GoogleForms) Detect(definition map[string]json.RawMessage) bool {
+	_, hasItems := definition["items"]
+	_, hasFormID := definition["formId"]
+	_, hasInfo := definition["info"]
+	return hasItems && (hasFormID || hasInfo)
+}
+
+// Import implements Importer.
+func (GoogleForms) Import(definition []byte) (survey.Snapshot, []Issue, error) {
+	f := googleForm{}
+	err := json.Unmarshal(definition, &f)
+	if err != nil {
+		return survey.Snapshot{}, nil, err
+	}
+	title := f.Info.Title
+	if title == "" {
+		title = f.Info.DocumentTitle
+	}
+	b := newBuilder(title, f.Info.Description)
+	if f.Settings.QuizSettings.IsQuiz {
+		b.unsupported("settings.quizSettings", "quiz", "The quiz is not supported, the questions are imported without the grading.")
+	}
+
+	for i, item := range f.Items {
+		path := "items[" + strconv.Itoa(i) + "]"
+		switch {
+		case item.PageBreakItem != nil:
+			b.addSection(item.Title, item.Description)
+		case item.QuestionItem != nil:
+			if item.QuestionItem.Image != nil {
+				b.unsupported(join(path, "questionItem.image"), "image", "The image of the question is not supported, it is skipped.")
+			}
+			googleFormsQuestion(b, item, item.QuestionItem.Question, join(path, "questionItem.question"))
+		case item.QuestionGroupItem != nil && item.QuestionGroupItem.Grid != nil:
+			googleFormsGrid(b, item, join(path, "questionGroupItem"))
+		case item.QuestionGroupItem != nil:
+			b.unsupported(join(path, "questionGroupItem"), "question group", "The question group other than the grid is not supported, it is skipped.")
+		case item.TextItem != nil, item.ImageItem != nil, item.VideoItem != nil:
+			b.unsupported(path, "text, image or video item", "The item is not a question, it is skipped.")
+		default:
+			b.unsupported(path, "item", "The item kind is not supported, it is skipped.")
+		}
+	}
+	return b.snapshot, b.issues, nil
+}
+
+// googleFormsQuestion adds the question gq of the item at the path.
+func googleFormsQuestion(b *builder, item googleFormItem, gq googleFormQuestion, path string) {
+	q := survey.SnapshotQuestion{QuestionText: item.Title, IsRequired: gq.Required}
+	config := survey.QuestionConfig{}
+	choices := []string{}
+	if item.Description != "" {
+		b.unsupported(path, "description", "The description of the question is not supported, it is skipped.")
+	}
+	if gq.Grading != nil {
+		b.unsupported(join(path, "grading"), "grading", "The grading of the quiz is not supported, it is skipped.")
+	}
+
+	switch {
+	case gq.ChoiceQuestion != nil:
+		q.Type = survey.QuestionTypeSingleChoice
+		if gq.ChoiceQuestion.Type == "CHECKBOX" {
+			q.Type = survey.QuestionTypeMultipleChoice
+		}
+		choices = googleFormsChoices(b, *gq.ChoiceQuestion, join(path, "choiceQuestion"))
+	case gq.TextQuestion != nil:
+		q.Type = survey.QuestionTypeShortText
+		if gq.TextQuestion.Paragraph {
+			q.Type = survey.QuestionTypeLongText
+		}
+	case gq.ScaleQuestion != nil:
+		q.Type = survey.QuestionTypeRating
+		config.ScaleMin, config.ScaleMax = &gq.ScaleQuestion.Low, &gq.ScaleQuestion.High
+		config.ScaleMinLabel, config.ScaleMaxLabel = gq.ScaleQuestion.LowLabel, gq.ScaleQuestion.HighLabel
+	case gq.RatingQuestion != nil:
+		q.Type = survey.QuestionTypeRating
+		min := int64(1)
+		config.ScaleMin, config.ScaleMax = &min, &gq.RatingQuestion.RatingScaleLevel
+	case gq.DateQuestion != nil:
+		q.Type = survey.QuestionTypeDate
+		if gq.DateQuestion.IncludeTime {
+			b.unsupported(join(path, "dateQuestion.includeTime"), "date with time", "The time is not supported, it is imported as date.")
+		}
+	case gq.TimeQuestion != nil:
+		q.Type = survey.QuestionTypeShortText
+		b.unsupported(join(path, "timeQuestion"), "time question", "The time question is not supported, it is imported as short text.")
+	case gq.FileUploadQuestion != nil:
+		q.Type = survey.QuestionTypeFileUpload
+		if len(gq.FileUploadQuestion.Types) > 0 {
+			b.unsupported(join(path, "fileUploadQuestion.types"), "file types "+strings.Join(gq.FileUploadQuestion.Types, ", "),
+				"The file types are not supported, any file extension is allowed.")
+		}
+	default:
+		b.unsupported(path, "question", "The question kind is not supported, it is skipped.")
+		return
+	}
+	q.Config = rawJSON(config)
+	b.addQuestion(q, "", choices, nil)
+}
+
+// googleFormsGrid adds the grid of the item at the path as a matrix question, the questions of the grid are the rows.
+func googleFormsGrid(b *builder, item googleFormItem, path string) {
+	group := item.QuestionGroupItem
+	q := survey.SnapshotQuestion{QuestionText: item.Title, Type: survey.QuestionTypeMatrix}
+	if group.Grid.Columns.Type == "CHECKBOX" {
+		b.unsupported(join(path, "grid.columns.type"), "checkbox grid", "The multiple choices per row are not supported, it is imported as a matrix question with a choice per row.")
+	}
+	rows := []string{}
+	for _, gq := range group.Questions {
+		q.IsRequired = q.IsRequired || gq.Required
+		if gq.RowQuestion != nil {
+			rows = append(rows, gq.RowQuestion.Title)
+		}
+	}
+	b.addQuestion(q, "", googleFormsChoices(b, group.Grid.Columns, join(path, "grid.columns")), rows)
+}
+
+// googleFormsChoices returns the choices of the choice question cq at the path, the other option and the branching are reported.
+func googleFormsChoices(b *builder, cq googleFormChoiceQuestion, path string) []string {
+	res := []string{}
+	if cq.Shuffle {
+		b.unsupported(join(path, "shuffle"), "shuffle", "The shuffled options are not supported, the options are imported in order.")
+	}
+	for i, o := range cq.Options {
+		optionPath := join(path, "options["+strconv.Itoa(i)+"]")
+		if o.GoToAction != "" || o.GoToSectionID != "" {
+			b.unsupported(optionPath, "go to section", "The branching is not supported, it is skipped.")
+		}
+		if o.IsOther {
+			res = append(res, "Other")
+			b.unsupported(optionPath, "other option", "The text of the other option is not supported, the other option is imported as a regular choice.")
+			continue
+		}
+		res = append(res, o.Value)
+	}
+	return res
+}
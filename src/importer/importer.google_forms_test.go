@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+const testGoogleForms = `{
+	"formId": "1FAIpQLSe",
+	"info": {"title": "Event Feedback", "description": "Tell us about the event"},
+	"items": [
+		{"itemId": "1", "title": "Which session did you attend?", "questionItem": {"question": {"questionId": "a1", "required": true,
+			"choiceQuestion": {"type": "RADIO", "options": [{"value": "Morning"}, {"value": "Evening", "goToAction": "SUBMIT_FORM"}, {"isOther": true}]}}}},
+		{"itemId": "2", "title": "How was it?", "questionItem": {"question": {"questionId": "a2",
+			"scaleQuestion": {"low": 1, "high": 10, "lowLabel": "Bad", "highLabel": "Great"}}}},
+		{"itemId": "3", "title": "Details", "pageBreakItem": {}},
+		{"itemId": "4", "title": "Rate the venue", "questionGroupItem": {
+			"questions": [{"questionId": "a3", "rowQuestion": {"title": "Food"}}, {"questionId": "a4", "required": true, "rowQuestion": {"title": "Seats"}}],
+			"grid": {"columns": {"type": "RADIO", "options": [{"value": "Poor"}, {"value": "Good"}]}}}},
+		{"itemId": "5", "title": "Anything else?", "questionItem": {"question": {"questionId": "a5", "textQuestion": {"paragraph": true}}}},
+		{"itemId": "6", "title": "Arrival time", "questionItem": {"question": {"questionId": "a6", "timeQuestion": {}}}},
+		{"itemId": "7", "title": "Thanks!", "textItem": {}}
+	]
+}`
+
+func TestGoogleForms(t *testing.T) {
+	if Detect([]byte(testGoogleForms)) != FormatGoogleForms {
+		t.Fatal("expected the definition detected as Google Forms")
+	}
+	sn, issues, err := GoogleForms{}.Import([]byte(testGoogleForms))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sn.Title != "Event Feedback" || len(sn.Sections) != 2 || sn.Sections[1].Title != "Details" {
+		t.Fatalf("expected the implicit first section and the page break as sections, got %+v", sn)
+	}
+
+	expected := []struct {
+		typ      string
+		choices  int
+		rows     int
+		required bool
+	}{
+		{survey.QuestionTypeSingleChoice, 3, 0, true},
+		{survey.QuestionTypeRating, 0, 0, false},
+		{survey.QuestionTypeMatrix, 2, 2, true},
+		{survey.QuestionTypeLongText, 0, 0, false},
+		{survey.QuestionTypeShortText, 0, 0, false},
+	}
+	if len(sn.Questions) != len(expected) {
+		t.Fatalf("expected %d questions, got %d", len(expected), len(sn.Questions))
+	}
+	for i, e := range expected {
+		q := sn.Questions[i]
+		if q.Type != e.typ || len(q.Choices) != e.choices || len(q.Rows) != e.rows || q.IsRequired != e.required {
+			t.Errorf("expected question %d to be %+v, got %+v", i, e, q)
+		}
+	}
+	if sn.Questions[2].SectionID != sn.Sections[1].ID || sn.Questions[2].Rows[1].RowText != "Seats" {
+		t.Errorf("expected the grid on the second section with its rows, got %+v", sn.Questions[2])
+	}
+
+	constructs := []string{}
+	for _, issue := range issues {
+		constructs = append(constructs, issue.Construct)
+	}
+	expectedConstructs := []string{"go to section", "other option", "time question", "text, image or video item"}
+	if len(constructs) != len(expectedConstructs) {
+		t.Fatalf("expected the unsupported %v, got %+v", expectedConstructs, issues)
+	}
+	for i, c := range expectedConstructs {
+		if constructs[i] != c {
+			t.Errorf("expected the unsupported %s, got %s", c, constructs[i])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// The formats of the survey definition supported by the built-in importers.
+const (
+	FormatSurveyJS    = "surveyjs"
+	FormatGoogleForms = "google_forms"
+)
+
+// Importer converts the survey definition of other tool into the survey Snapshot, use Register to add the importer of other format.
+type Importer interface {
+	// Detect reports whether the definition is in the format of the importer, it is used when the format is not specified.
+	Detect(definition map[string]json.RawMessage) bool
+
+	// Import returns the survey Snapshot of the definition along with the constructs which are not imported as is.
+	Import(definition []byte) (survey.Snapshot, []Issue, error)
+}
+
+// importers are the registered importers, keyed by the format.
+var importers = map[string]Importer{
+	FormatSurveyJS:    SurveyJS{},
+	FormatGoogleForms: GoogleForms{},
+}
+
+// Register registers the importer i for the format, the importer of the same format is replaced.
+func Register(format string, i Importer) {
+	importers[format] = i
+}
+
+// Formats returns the sorted formats of the registered importers.
+func Formats() []string {
+	res := []string{}
+	for f := range importers {
+		res = append(res, f)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Detect returns the format of the definition, it returns empty string when the definition is not in any of the registered formats.
+func Detect(definition []byte) string {
+	m := map[string]json.RawMessage{}
+	if json.Unmarshal(definition, &m) != nil {
+		return ""
+	}
+	for _, f := range Formats() {
+		if importers[f].Detect(m) {
+			return f
+		}
+	}
+	return ""
+}
+
+// Issue is a construct of the definition which is not supported by the survey, it is either skipped or imported partially
+// as described by the message.
+type Issue struct {
+	Path      string `json:"path"`      // the location of the construct on the definition, for example pages[0].elements[2]
+	Construct string `json:"construct"` // the unsupported construct, for example a question type or a property
+	Message   string `json:"message"`   // what is done with the construct
+}
+
+// Result is the result of the import, the created survey along with the unsupported constructs of the definition.
+type Result struct {
+	SurveyID      string  `json:"survey_id"`
+	Format        string  `json:"format"`
+	SectionCount  int     `json:"section_count"`
+	QuestionCount int     `json:"question_count"`
+	Unsupported   []Issue `json:"unsupported"`
+}
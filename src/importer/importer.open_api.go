@@ -0,0 +1,48 @@
+package importer
+
+import "github.com/survey-app/survey/app"
+
+// OpenAPI is constructor for *openAPI, to autogenerate open api document.
+func OpenAPI() *OpenAPIOperation {
+	return &OpenAPIOperation{}
+}
+
+// OpenAPIOperation embed from app.OpenAPIOperation for simplicity, used for autogenerate open api document.
+type OpenAPIOperation struct {
+	app.OpenAPIOperation
+}
+
+// Base is common detail of imports open api document component.
+func (o *OpenAPIOperation) Base() {
+	o.Tags = []string{"Import"}
+	o.HeaderParams = []map[string]any{{"$ref": "#/components/parameters/headerParam.Accept-Language"}}
+	o.Responses = map[string]map[string]any{
+		"201": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Result{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	o.Securities = []map[string][]string{}
+}
+
+// Import is detail of `POST /api/v1/surveys/import` open api document component.
+func (o *OpenAPIOperation) Import() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Import Survey"
+	o.Description = "Use this method to create a draft Survey from the definition of other tool, the body is the definition as is. " +
+		"The pages, the question types, the choices and the required flags are imported, " +
+		"and the constructs which are not supported (such as the conditions) are listed on the unsupported of the result"
+	o.QueryParams = []map[string]any{
+		{"name": "format", "in": "query", "description": "The format of the definition, it is detected when it is undefined", "schema": map[string]any{"type": "string", "enum": Formats()}},
+		{"name": "title", "in": "query", "description": "The title of the survey, the title of the definition is used when it is undefined", "schema": map[string]any{"type": "string"}},
+	}
+	o.Body = map[string]any{"application/json": map[string]any{"schema": map[string]any{"type": "object"}}}
+	return o
+}
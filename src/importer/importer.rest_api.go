@@ -0,0 +1,42 @@
+package importer
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/survey-app/survey/app"
+)
+
+// REST returns a *RESTAPIHandler.
+func REST() *RESTAPIHandler {
+	return &RESTAPIHandler{}
+}
+
+// RESTAPIHandler provides a convenient interface for Import REST API handler.
+type RESTAPIHandler struct {
+	UseCase UseCaseHandler
+}
+
+// injectDeps inject the dependencies of the Import REST API handler.
+func (r *RESTAPIHandler) injectDeps(c *fiber.Ctx) error {
+	ctx, ok := c.Locals(app.CtxKey).(*app.Ctx)
+	if !ok {
+		return app.NewError(http.StatusInternalServerError, "ctx is not found")
+	}
+	r.UseCase = UseCase(*ctx, app.ParseQuery(c))
+	return nil
+}
+
+// Import is the REST API handler for `POST /api/v1/surveys/import?format={format}&title={title}`, the body is the definition.
+func (r *RESTAPIHandler) Import(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.Import(c.Query("format"), c.Query("title"), c.Body())
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(res)
+}
@@ -0,0 +1,89 @@
+package importer
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2/utils"
+
+	"github.com/survey-app/survey/app"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"surveys.create",
+	}))
+	app.Server().AddRoute("/surveys/import", "POST", REST().Import, nil)
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+
+		description:  "Import malformed definition",
+		method:       "POST",
+		path:         "/surveys/import",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"pages": [`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Import definition of unknown format",
+		method:       "POST",
+		path:         "/surveys/import",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"name": "unknown"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Import SurveyJS definition without question",
+		method:       "POST",
+		path:         "/surveys/import?format=surveyjs",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"pages": [{"name": "page1", "elements": [{"type": "html", "name": "info"}]}]}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+}
+
+// TestImportREST tests the REST API of Import data with specified scenario.
+func TestImportREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
@@ -0,0 +1,454 @@
+package importer
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// SurveyJS is the Importer of the SurveyJS survey JSON (https://surveyjs.io/form-library/documentation/design-survey/create-a-simple-survey).
+// The pages are imported as sections, and the questions of the panels are placed on the page of the panel. The conditions
+// (visibleIf, enableIf, etc) are SurveyJS expressions which are not converted, they are reported along with the other unsupported constructs.
+type SurveyJS struct{}
+
+// surveyJSDefinition is the root of the SurveyJS survey JSON, the elements (or the questions) without page are placed on a single page.
+type surveyJSDefinition struct {
+	Title       surveyJSText      `json:"title"`
+	Description surveyJSText      `json:"description"`
+	Pages       []surveyJSPage    `json:"pages"`
+	Elements    []json.RawMessage `json:"elements"`
+	Questions   []json.RawMessage `json:"questions"`
+}
+
+// surveyJSPage is the page of the SurveyJS survey, older versions use questions instead of elements.
+type surveyJSPage struct {
+	Name        string            `json:"name"`
+	Title       surveyJSText      `json:"title"`
+	Description surveyJSText      `json:"description"`
+	Elements    []json.RawMessage `json:"elements"`
+	Questions   []json.RawMessage `json:"questions"`
+}
+
+// surveyJSElement is the question or the panel of the SurveyJS survey, only the properties which can be imported are defined.
+type surveyJSElement struct {
+	Type               string              `json:"type"`
+	Name               string              `json:"name"`
+	Title              surveyJSText        `json:"title"`
+	IsRequired         bool                `json:"isRequired"`
+	IsAllRowRequired   bool                `json:"isAllRowRequired"`
+	InputType          string              `json:"inputType"`
+	Placeholder        surveyJSText        `json:"placeholder"`
+	PlaceHolder        surveyJSText        `json:"placeHolder"`
+	Choices            []surveyJSItem      `json:"choices"`
+	Rows               []surveyJSItem      `json:"rows"`
+	Columns            []surveyJSItem      `json:"columns"`
+	HasOther           bool                `json:"hasOther"`
+	ShowOtherItem      bool                `json:"showOtherItem"`
+	OtherText          surveyJSText        `json:"otherText"`
+	HasNone            bool                `json:"hasNone"`
+	ShowNoneItem       bool                `json:"showNoneItem"`
+	NoneText           surveyJSText        `json:"noneText"`
+	LabelTrue          surveyJSText        `json:"labelTrue"`
+	LabelFalse         surveyJSText        `json:"labelFalse"`
+	RateMin            *int64              `json:"rateMin"`
+	RateMax            *int64              `json:"rateMax"`
+	RateCount          *int64              `json:"rateCount"`
+	RateValues         []surveyJSItem      `json:"rateValues"`
+	MinRateDescription surveyJSText        `json:"minRateDescription"`
+	MaxRateDescription surveyJSText        `json:"maxRateDescription"`
+	Min                surveyJSLimit       `json:"min"`
+	Max                surveyJSLimit       `json:"max"`
+	MaxLength          *int                `json:"maxLength"`
+	MinSelectedChoices *int                `json:"minSelectedChoices"`
+	MaxSelectedChoices *int                `json:"maxSelectedChoices"`
+	AcceptedTypes      string              `json:"acceptedTypes"`
+	MultiSelect        bool                `json:"multiSelect"`
+	Validators         []surveyJSValidator `json:"validators"`
+	Elements           []json.RawMessage   `json:"elements"`
+	Questions          []json.RawMessage   `json:"questions"`
+}
+
+// surveyJSValidator is the validator of the SurveyJS question.
+type surveyJSValidator struct {
+	Type      string   `json:"type"`
+	MinValue  *float64 `json:"minValue"`
+	MaxValue  *float64 `json:"maxValue"`
+	MinLength *int     `json:"minLength"`
+	MaxLength *int     `json:"maxLength"`
+	MinCount  *int     `json:"minCount"`
+	MaxCount  *int     `json:"maxCount"`
+	Regex     string   `json:"regex"`
+}
+
+// surveyJSText is the text of the SurveyJS survey, either a string or the localized strings keyed by the locale.
+// The default locale is used, or the first locale when there is no default.
+type surveyJSText struct {
+	Text    string
+	Locales int
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *surveyJSText) UnmarshalJSON(b []byte) error {
+	if json.Unmarshal(b, &t.Text) == nil {
+		return nil
+	}
+	localized := map[string]string{}
+	err := json.Unmarshal(b, &localized)
+	if err != nil {
+		return err
+	}
+	t.Locales = len(localized)
+	locales := []string{}
+	for l := range localized {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+	for _, l := range append([]string{"default", "en"}, locales...) {
+		if text, ok := localized[l]; ok {
+			t.Text = text
+			return nil
+		}
+	}
+	return nil
+}
+
+// surveyJSLimit is the minimum or the maximum of the SurveyJS text question, either a number or a date depending on the input type.
+type surveyJSLimit struct {
+	Number *float64
+	Text   string
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *surveyJSLimit) UnmarshalJSON(b []byte) error {
+	l.Text = strings.Trim(string(b), `"`)
+	f, err := strconv.ParseFloat(l.Text, 64)
+	if err == nil {
+		l.Number = &f
+	}
+	return nil
+}
+
+// surveyJSItem is the choice, the row or the column of the SurveyJS question, either a value or an object with the value and the text.
+type surveyJSItem struct {
+	Value string
+	Text  surveyJSText
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (item *surveyJSItem) UnmarshalJSON(b []byte) error {
+	obj := struct {
+		Value json.RawMessage `json:"value"`
+		Text  surveyJSText    `json:"text"`
+	}{}
+	if json.Unmarshal(b, &obj) != nil {
+		obj.Value = b
+	}
+	item.Value = strings.Trim(string(obj.Value), `"`)
+	item.Text = obj.Text
+	if item.Text.Text == "" {
+		item.Text.Text = item.Value
+	}
+	return nil
+}
+
+// The properties of the SurveyJS survey, the page and the question which are not supported by the survey.
+var (
+	surveyJSUnsupportedSurvey   = []string{"triggers", "calculatedValues", "completedHtml", "completedHtmlOnCondition", "navigateToUrl", "navigateToUrlOnCondition"}
+	surveyJSUnsupportedPage     = []string{"visibleIf", "enableIf"}
+	surveyJSUnsupportedQuestion = []string{"visibleIf", "enableIf", "requiredIf", "defaultValue", "correctAnswer", "choicesByUrl", "choicesFromQuestion", "choicesVisibleIf", "hasComment", "showCommentArea", "description"}
+)
+
+// Detect implements Importer, the SurveyJS survey has the pages or the elements.
+func (SurveyJS) Detect(definition map[string]json.RawMessage) bool {
+	_, hasPages := definition["pages"]
+	_, hasElements := definition["elements"]
+	return hasPages || hasElements
+}
+
+// Import implements Importer.
+func (SurveyJS) Import(definition []byte) (survey.Snapshot, []Issue, error) {
+	d := surveyJSDefinition{}
+	err := json.Unmarshal(definition, &d)
+	if err != nil {
+		return survey.Snapshot{}, nil, err
+	}
+	b := newBuilder(d.Title.Text, d.Description.Text)
+	sj := surveyJSImport{builder: b}
+	sj.text(d.Title, "title")
+	sj.unsupportedProperties(definition, "", surveyJSUnsupportedSurvey)
+
+	if len(d.Elements) > 0 || len(d.Questions) > 0 {
+		b.addSection("", "")
+		sj.elements(append(d.Elements, d.Questions...), "")
+	}
+	raw := struct {
+		Pages []json.RawMessage `json:"pages"`
+	}{}
+	err = json.Unmarshal(definition, &raw)
+	if err != nil {
+		return survey.Snapshot{}, nil, err
+	}
+	for i, p := range d.Pages {
+		path := "pages[" + strconv.Itoa(i) + "]"
+		title := sj.text(p.Title, join(path, "title"))
+		if title == "" {
+			title = p.Name
+		}
+		b.addSection(title, sj.text(p.Description, join(path, "description")))
+		sj.unsupportedProperties(raw.Pages[i], path, surveyJSUnsupportedPage)
+		sj.elements(append(p.Elements, p.Questions...), path)
+	}
+	return b.snapshot, b.issues, nil
+}
+
+// surveyJSImport is the state of the import of a SurveyJS survey.
+type surveyJSImport struct {
+	builder *builder
+}
+
+// text returns the text t, the translations of the localized text are reported once.
+func (sj surveyJSImport) text(t surveyJSText, path string) string {
+	if t.Locales > 1 {
+		sj.builder.unsupportedOnce(path, "translations", "Only the default locale of the localized texts is imported.")
+	}
+	return t.Text
+}
+
+// unsupportedProperties reports the unsupported properties defined on the object raw at the path.
+func (sj surveyJSImport) unsupportedProperties(raw json.RawMessage, path string, properties []string) {
+	m := map[string]json.RawMessage{}
+	if json.Unmarshal(raw, &m) != nil {
+		return
+	}
+	for _, p := range properties {
+		v := strings.TrimSpace(string(m[p]))
+		if v == "" || v == "null" || v == "false" || v == `""` || v == "[]" || v == "{}" {
+			continue
+		}
+		sj.builder.unsupported(join(path, p), p, "The property is not supported, it is skipped.")
+	}
+}
+
+// elements imports the elements of a page or a panel at the path.
+func (sj surveyJSImport) elements(elements []json.RawMessage, path string) {
+	for i, raw := range elements {
+		elementPath := join(path, "elements["+strconv.Itoa(i)+"]")
+		e := surveyJSElement{}
+		err := json.Unmarshal(raw, &e)
+		if err != nil {
+			sj.builder.unsupported(elementPath, "element", "The element is malformed, it is skipped: "+err.Error()+".")
+			continue
+		}
+		sj.element(e, raw, elementPath)
+	}
+}
+
+// element imports the element e at the path.
+func (sj surveyJSImport) element(e surveyJSElement, raw json.RawMessage, path string) {
+	b := sj.builder
+	q := survey.SnapshotQuestion{QuestionText: sj.text(e.Title, join(path, "title")), IsRequired: e.IsRequired}
+	if q.QuestionText == "" {
+		q.QuestionText = e.Name
+	}
+	choices := []string{}
+	for _, c := range e.Choices {
+		choices = append(choices, sj.text(c.Text, join(path, "choices")))
+	}
+	config := survey.QuestionConfig{Placeholder: sj.text(e.Placeholder, join(path, "placeholder"))}
+	if config.Placeholder == "" {
+		config.Placeholder = e.PlaceHolder.Text
+	}
+	validation := sj.validation(e, path)
+	rows := []string{}
+
+	switch e.Type {
+	case "text":
+		q.Type = survey.QuestionTypeShortText
+		switch e.InputType {
+		case "", "text", "email", "tel", "url", "password", "search":
+		case "number", "range":
+			q.Type = survey.QuestionTypeNumber
+
+			// the min and the max of the element take precedence over the numeric validator
+			if e.Min.Number != nil {
+				validation.Min = e.Min.Number
+			}
+			if e.Max.Number != nil {
+				validation.Max = e.Max.Number
+			}
+		case "date", "datetime-local":
+			q.Type = survey.QuestionTypeDate
+			if e.Min.Text != "" {
+				validation.MinDate = date(e.Min.Text)
+			}
+			if e.Max.Text != "" {
+				validation.MaxDate = date(e.Max.Text)
+			}
+			if e.InputType == "datetime-local" {
+				b.unsupported(join(path, "inputType"), "inputType "+e.InputType, "The time is not supported, it is imported as date.")
+			}
+		default:
+			b.unsupported(join(path, "inputType"), "inputType "+e.InputType, "The input type is not supported, it is imported as short text.")
+		}
+		if e.MaxLength != nil && *e.MaxLength > 0 && q.Type == survey.QuestionTypeShortText {
+			validation.MaxLength = e.MaxLength
+		}
+	case "comment":
+		q.Type = survey.QuestionTypeLongText
+		if e.MaxLength != nil && *e.MaxLength > 0 {
+			validation.MaxLength = e.MaxLength
+		}
+	case "radiogroup", "dropdown":
+		q.Type = survey.QuestionTypeSingleChoice
+		choices = sj.specialChoices(e, path, choices)
+	case "checkbox", "tagbox":
+		q.Type = survey.QuestionTypeMultipleChoice
+		choices = sj.specialChoices(e, path, choices)
+		if validation.MinSelected == nil {
+			validation.MinSelected = e.MinSelectedChoices
+		}
+		if validation.MaxSelected == nil {
+			validation.MaxSelected = e.MaxSelectedChoices
+		}
+	case "imagepicker":
+		q.Type = survey.QuestionTypeSingleChoice
+		if e.MultiSelect {
+			q.Type = survey.QuestionTypeMultipleChoice
+		}
+		b.unsupported(join(path, "choices"), "image choices", "The images of the choices are not supported, the choices are imported as text.")
+	case "boolean":
+		q.Type = survey.QuestionTypeSingleChoice
+		choices = []string{sj.text(e.LabelTrue, join(path, "labelTrue")), sj.text(e.LabelFalse, join(path, "labelFalse"))}
+		if choices[0] == "" {
+			choices[0] = "Yes"
+		}
+		if choices[1] == "" {
+			choices[1] = "No"
+		}
+	case "rating":
+		q.Type = survey.QuestionTypeRating
+		if len(e.RateValues) > 0 {
+			q.Type = survey.QuestionTypeSingleChoice
+			choices = []string{}
+			for _, v := range e.RateValues {
+				choices = append(choices, sj.text(v.Text, join(path, "rateValues")))
+			}
+			b.unsupported(join(path, "rateValues"), "rateValues", "The custom rate values are not supported, the question is imported as single choice.")
+			break
+		}
+		min, max := int64(1), int64(5)
+		if e.RateMin != nil {
+			min = *e.RateMin
+		}
+		if e.RateMax != nil {
+			max = *e.RateMax
+		} else if e.RateCount != nil {
+			max = min + *e.RateCount - 1
+		}
+		config.ScaleMin, config.ScaleMax = &min, &max
+		config.ScaleMinLabel = sj.text(e.MinRateDescription, join(path, "minRateDescription"))
+		config.ScaleMaxLabel = sj.text(e.MaxRateDescription, join(path, "maxRateDescription"))
+	case "ranking":
+		q.Type = survey.QuestionTypeRanking
+	case "matrix":
+		q.Type = survey.QuestionTypeMatrix
+		q.IsRequired = e.IsRequired || e.IsAllRowRequired
+		choices = []string{}
+		for _, c := range e.Columns {
+			choices = append(choices, sj.text(c.Text, join(path, "columns")))
+		}
+		for _, r := range e.Rows {
+			rows = append(rows, sj.text(r.Text, join(path, "rows")))
+		}
+	case "file":
+		q.Type = survey.QuestionTypeFileUpload
+		for _, t := range strings.Split(e.AcceptedTypes, ",") {
+			t = strings.TrimSpace(t)
+			if strings.HasPrefix(t, ".") {
+				config.AllowedExtensions = append(config.AllowedExtensions, t)
+			} else if t != "" {
+				b.unsupported(join(path, "acceptedTypes"), "acceptedTypes "+t, "Only the file extensions are supported, the mime type is skipped.")
+			}
+		}
+	case "panel":
+		b.unsupported(path, "panel", "The panel is not supported, its questions are placed on the page of the panel.")
+		sj.unsupportedProperties(raw, path, surveyJSUnsupportedPage)
+		sj.elements(append(e.Elements, e.Questions...), path)
+		return
+	case "html", "image", "expression":
+		b.unsupported(path, "type "+e.Type, "The element is not a question, it is skipped.")
+		return
+	default:
+		b.unsupported(path, "type "+e.Type, "The question type is not supported, it is skipped.")
+		return
+	}
+
+	sj.unsupportedProperties(raw, path, surveyJSUnsupportedQuestion)
+	q.Config = rawJSON(config)
+	q.Validation = rawJSON(validation)
+	b.addQuestion(q, e.Name, choices, rows)
+}
+
+// specialChoices returns the choices along with the none and the other choice of the question e, the comment of the other choice is reported.
+func (sj surveyJSImport) specialChoices(e surveyJSElement, path string, choices []string) []string {
+	if e.HasNone || e.ShowNoneItem {
+		text := sj.text(e.NoneText, join(path, "noneText"))
+		if text == "" {
+			text = "None"
+		}
+		choices = append(choices, text)
+	}
+	if e.HasOther || e.ShowOtherItem {
+		text := sj.text(e.OtherText, join(path, "otherText"))
+		if text == "" {
+			text = "Other (describe)"
+		}
+		choices = append(choices, text)
+		sj.builder.unsupported(join(path, "showOtherItem"), "other choice comment", "The comment of the other choice is not supported, the other choice is imported as a regular choice.")
+	}
+	return choices
+}
+
+// validation returns the validation rules of the validators of the question e, the unsupported validator is reported.
+func (sj surveyJSImport) validation(e surveyJSElement, path string) survey.QuestionValidation {
+	res := survey.QuestionValidation{}
+	for i, v := range e.Validators {
+		switch v.Type {
+		case "numeric":
+			res.Min, res.Max = v.MinValue, v.MaxValue
+		case "text":
+			res.MinLength, res.MaxLength = v.MinLength, v.MaxLength
+		case "answercount":
+			res.MinSelected, res.MaxSelected = v.MinCount, v.MaxCount
+		case "regex":
+			res.Pattern = v.Regex
+		default:
+			sj.builder.unsupported(join(path, "validators["+strconv.Itoa(i)+"]"), "validator "+v.Type, "The validator is not supported, it is skipped.")
+		}
+	}
+	return res
+}
+
+// date returns the date part (YYYY-MM-DD) of the value v, it returns empty string when v is not a date.
+func date(v string) string {
+	if len(v) < 10 {
+		return ""
+	}
+	_, err := time.Parse("2006-01-02", v[:10])
+	if err != nil {
+		return ""
+	}
+	return v[:10]
+}
+
+// join returns the path of the property of the object at the path.
+func join(path, property string) string {
+	if path == "" {
+		return property
+	}
+	return path + "." + property
+}
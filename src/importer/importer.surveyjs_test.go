@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+const testSurveyJS = `{
+	"title": {"default": "Customer Survey", "id": "Survei Pelanggan"},
+	"triggers": [{"type": "complete"}],
+	"pages": [
+		{
+			"name": "page1",
+			"elements": [
+				{"type": "text", "name": "full name", "title": "Your name", "isRequired": true, "maxLength": 50},
+				{"type": "text", "name": "age", "inputType": "number", "min": 17, "max": "99"},
+				{"type": "radiogroup", "name": "plan", "choices": ["Free", {"value": "pro", "text": "Pro"}], "showOtherItem": true},
+				{"type": "checkbox", "name": "features", "choices": ["A", "B", "C"], "visibleIf": "{plan} = 'pro'",
+					"validators": [{"type": "answercount", "maxCount": 2}, {"type": "expression", "expression": "true"}]}
+			]
+		},
+		{
+			"name": "page2",
+			"title": "Feedback",
+			"elements": [
+				{"type": "rating", "name": "score", "rateMin": 0, "rateMax": 10, "minRateDescription": "Never"},
+				{"type": "matrix", "name": "quality", "columns": ["Bad", "Good"], "rows": [{"value": "r1", "text": "Speed"}, "Price"], "isAllRowRequired": true},
+				{"type": "panel", "name": "extra", "elements": [{"type": "comment", "name": "comment"}, {"type": "boolean", "name": "contact"}]},
+				{"type": "html", "name": "info", "html": "<b>Thanks</b>"},
+				{"type": "paneldynamic", "name": "children"},
+				{"type": "text", "name": "age"}
+			]
+		}
+	]
+}`
+
+func TestSurveyJS(t *testing.T) {
+	if Detect([]byte(testSurveyJS)) != FormatSurveyJS {
+		t.Fatal("expected the definition detected as SurveyJS")
+	}
+	sn, issues, err := SurveyJS{}.Import([]byte(testSurveyJS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sn.Title != "Customer Survey" || len(sn.Sections) != 2 || sn.Sections[0].Title != "page1" || sn.Sections[1].Title != "Feedback" {
+		t.Fatalf("expected the default title and the pages as sections, got %+v", sn)
+	}
+
+	expected := []struct {
+		code     string
+		typ      string
+		choices  int
+		rows     int
+		required bool
+	}{
+		{"full_name", survey.QuestionTypeShortText, 0, 0, true},
+		{"age", survey.QuestionTypeNumber, 0, 0, false},
+		{"plan", survey.QuestionTypeSingleChoice, 3, 0, false},
+		{"features", survey.QuestionTypeMultipleChoice, 3, 0, false},
+		{"score", survey.QuestionTypeRating, 0, 0, false},
+		{"quality", survey.QuestionTypeMatrix, 2, 2, true},
+		{"comment", survey.QuestionTypeLongText, 0, 0, false},
+		{"contact", survey.QuestionTypeSingleChoice, 2, 0, false},
+		{"age_2", survey.QuestionTypeShortText, 0, 0, false},
+	}
+	if len(sn.Questions) != len(expected) {
+		t.Fatalf("expected %d questions, got %d", len(expected), len(sn.Questions))
+	}
+	for i, e := range expected {
+		q := sn.Questions[i]
+		if q.Code != e.code || q.Type != e.typ || len(q.Choices) != e.choices || len(q.Rows) != e.rows || q.IsRequired != e.required || q.Position != int64(i+1) {
+			t.Errorf("expected question %d to be %+v, got %+v", i, e, q)
+		}
+	}
+	if sn.Questions[2].Choices[1].ChoiceText != "Pro" || sn.Questions[5].Rows[0].RowText != "Speed" || sn.Questions[7].Choices[0].ChoiceText != "Yes" {
+		t.Errorf("expected the text of the choices and the rows, got %+v", sn.Questions)
+	}
+	if sn.Questions[6].SectionID != sn.Sections[1].ID {
+		t.Error("expected the question of the panel placed on the page of the panel")
+	}
+
+	validation := survey.QuestionValidation{}
+	json.Unmarshal(sn.Questions[1].Validation, &validation)
+	if validation.Min == nil || *validation.Min != 17 || validation.Max == nil || *validation.Max != 99 {
+		t.Errorf("expected the min and the max of the number question, got %s", sn.Questions[1].Validation)
+	}
+	json.Unmarshal(sn.Questions[3].Validation, &validation)
+	if validation.MaxSelected == nil || *validation.MaxSelected != 2 {
+		t.Errorf("expected the max selected of the answer count validator, got %s", sn.Questions[3].Validation)
+	}
+	config := survey.QuestionConfig{}
+	json.Unmarshal(sn.Questions[4].Config, &config)
+	if min, max := config.Scale(); min != 0 || max != 10 || config.ScaleMinLabel != "Never" {
+		t.Errorf("expected the scale 0 to 10 of the rating question, got %s", sn.Questions[4].Config)
+	}
+
+	constructs := map[string]string{}
+	for _, issue := range issues {
+		constructs[issue.Construct] = issue.Path
+	}
+	for construct, path := range map[string]string{
+		"translations":         "title",
+		"triggers":             "triggers",
+		"other choice comment": "pages[0].elements[2].showOtherItem",
+		"visibleIf":            "pages[0].elements[3].visibleIf",
+		"validator expression": "pages[0].elements[3].validators[1]",
+		"panel":                "pages[1].elements[2]",
+		"type html":            "pages[1].elements[3]",
+		"type paneldynamic":    "pages[1].elements[4]",
+	} {
+		if constructs[construct] != path {
+			t.Errorf("expected the unsupported %s at %s, got %q", construct, path, constructs[construct])
+		}
+	}
+	if len(issues) != 8 {
+		t.Errorf("expected 8 unsupported constructs, got %+v", issues)
+	}
+}
+
+func TestSurveyJSNumericValidator(t *testing.T) {
+	sn, issues, err := SurveyJS{}.Import([]byte(`{"pages": [{"name": "page1", "elements": [
+		{"type": "text", "name": "age", "inputType": "number", "validators": [{"type": "numeric", "minValue": 17, "maxValue": 99}]},
+		{"type": "text", "name": "score", "inputType": "number", "min": 1, "validators": [{"type": "numeric", "minValue": 0, "maxValue": 10}]}
+	]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sn.Questions) != 2 || len(issues) != 0 {
+		t.Fatalf("expected 2 questions without unsupported constructs, got %+v, %+v", sn.Questions, issues)
+	}
+
+	// the bounds of the numeric validator are kept when the element does not set its min and max
+	validation := survey.QuestionValidation{}
+	json.Unmarshal(sn.Questions[0].Validation, &validation)
+	if validation.Min == nil || *validation.Min != 17 || validation.Max == nil || *validation.Max != 99 {
+		t.Errorf("expected the min and the max of the numeric validator, got %s", sn.Questions[0].Validation)
+	}
+	validation = survey.QuestionValidation{}
+	json.Unmarshal(sn.Questions[1].Validation, &validation)
+	if validation.Min == nil || *validation.Min != 1 || validation.Max == nil || *validation.Max != 10 {
+		t.Errorf("expected the min of the element along with the max of the numeric validator, got %s", sn.Questions[1].Validation)
+	}
+}
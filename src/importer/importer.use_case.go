@@ -0,0 +1,105 @@
+package importer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Import use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Import creates a new draft survey from the definition in the format, the format is detected when it is empty.
+// The title of the definition is replaced with the title when it is not empty. The constructs of the definition which are not
+// supported by the survey are returned on the result instead of failing the import.
+func (u UseCaseHandler) Import(format, title string, definition []byte) (Result, error) {
+	res := Result{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.create")
+	if err != nil {
+		return res, err
+	}
+
+	// validate the definition and its format
+	if !json.Valid(definition) {
+		return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("import_definition_invalid", map[string]string{
+			"message": "the definition is not a valid json",
+		}))
+	}
+	if format == "" {
+		format = Detect(definition)
+	}
+	if format == "" {
+		return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("import_format_unknown", map[string]string{
+			"formats": strings.Join(Formats(), ", "),
+		}))
+	}
+	i, ok := importers[format]
+	if !ok {
+		return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("import_format_unsupported", map[string]string{
+			"format":  format,
+			"formats": strings.Join(Formats(), ", "),
+		}))
+	}
+
+	// convert the definition into the survey structure
+	sn, issues, err := i.Import(definition)
+	if err != nil {
+		return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("import_definition_invalid", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	if title != "" {
+		sn.Title = title
+	}
+	if sn.Title == "" {
+		sn.Title = u.Ctx.Trans("import_untitled")
+	}
+	b, err := json.Marshal(sn)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	structure := app.NullJSON{}
+	err = json.Unmarshal(b, &structure)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	err = survey.ValidateStructure(u.Ctx, structure)
+	if err != nil {
+		return res, err
+	}
+
+	// create the survey under fresh ids
+	id, err := survey.UseCase(*u.Ctx).CreateCopy(sn.Survey(), survey.ParamDuplicate{})
+	if err != nil {
+		return res, err
+	}
+	res.SurveyID = id
+	res.Format = format
+	res.SectionCount = len(sn.Sections)
+	res.QuestionCount = len(sn.Questions)
+	res.Unsupported = issues
+	return res, nil
+}
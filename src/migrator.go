@@ -3,10 +3,15 @@ package src
 import (
 	"github.com/survey-app/survey/app"
 	"github.com/survey-app/survey/src/answer"
+	"github.com/survey-app/survey/src/bank"
 	"github.com/survey-app/survey/src/choice"
+	"github.com/survey-app/survey/src/export"
 	"github.com/survey-app/survey/src/question"
 	"github.com/survey-app/survey/src/response"
+	"github.com/survey-app/survey/src/row"
+	"github.com/survey-app/survey/src/section"
 	"github.com/survey-app/survey/src/survey"
+	"github.com/survey-app/survey/src/template"
 	// import : DONT REMOVE THIS COMMENT
 )
 
@@ -30,10 +35,17 @@ type migratorUtil struct {
 
 func (*migratorUtil) Configure() {
 	app.DB().RegisterTable("main", survey.Survey{})
+	app.DB().RegisterTable("main", survey.Transition{})
+	app.DB().RegisterTable("main", survey.Version{})
+	app.DB().RegisterTable("main", section.Section{})
 	app.DB().RegisterTable("main", question.Question{})
 	app.DB().RegisterTable("main", choice.Choice{})
+	app.DB().RegisterTable("main", row.Row{})
 	app.DB().RegisterTable("main", response.Response{})
 	app.DB().RegisterTable("main", answer.Answer{})
+	app.DB().RegisterTable("main", template.Template{})
+	app.DB().RegisterTable("main", bank.Question{})
+	app.DB().RegisterTable("main", export.Job{})
 	// RegisterTable : DONT REMOVE THIS COMMENT
 }
 
@@ -5,13 +5,22 @@ import "github.com/survey-app/survey/app"
 // Question is the main model of Question data. It provides a convenient interface for app.ModelInterface
 type Question struct {
 	app.Model
-	ID           app.NullUUID     `json:"id"            db:"m.id"            gorm:"column:id;primaryKey"`
-	SurveyId     app.NullUUID     `json:"survey_id"     db:"m.survey_id"     gorm:"column:survey_id"`
-	QuestionText app.NullText     `json:"question_text" db:"m.question_text" gorm:"column:question_text"`
-	IsActive     app.NullBool     `json:"is_active"     db:"m.is_active"     gorm:"column:is_active"`
-	CreatedAt    app.NullDateTime `json:"created_at"    db:"m.created_at"    gorm:"column:created_at"`
-	UpdatedAt    app.NullDateTime `json:"updated_at"    db:"m.updated_at"    gorm:"column:updated_at"`
-	DeletedAt    app.NullDateTime `json:"deleted_at"    db:"m.deleted_at"    gorm:"column:deleted_at"`
+	ID             app.NullUUID     `json:"id"               db:"m.id"               gorm:"column:id;primaryKey"`
+	SurveyId       app.NullUUID     `json:"survey_id"        db:"m.survey_id"        gorm:"column:survey_id"`
+	SectionId      app.NullUUID     `json:"section_id"       db:"m.section_id"       gorm:"column:section_id"`
+	Code           app.NullString   `json:"code"             db:"m.code"             gorm:"column:code"             validate:"omitempty,max=64"`
+	QuestionText   app.NullText     `json:"question_text"    db:"m.question_text"    gorm:"column:question_text"`
+	Type           app.NullString   `json:"type"             db:"m.type"             gorm:"column:type"             validate:"omitempty,oneof=single_choice multiple_choice short_text long_text number date rating file_upload matrix nps ranking"`
+	Config         app.NullJSON     `json:"config"           db:"m.config"           gorm:"column:config"`
+	IsRequired     app.NullBool     `json:"is_required"      db:"m.is_required"      gorm:"column:is_required"`
+	Validation     app.NullJSON     `json:"validation"       db:"m.validation"       gorm:"column:validation"`
+	Position       app.NullInt64    `json:"position"         db:"m.position"         gorm:"column:position"`
+	VisibleIf      app.NullJSON     `json:"visible_if"       db:"m.visible_if"       gorm:"column:visible_if"`
+	BankQuestionId app.NullUUID     `json:"bank_question_id" db:"m.bank_question_id" gorm:"column:bank_question_id"`
+	IsActive       app.NullBool     `json:"is_active"        db:"m.is_active"        gorm:"column:is_active"`
+	CreatedAt      app.NullDateTime `json:"created_at"       db:"m.created_at"       gorm:"column:created_at"`
+	UpdatedAt      app.NullDateTime `json:"updated_at"       db:"m.updated_at"       gorm:"column:updated_at"`
+	DeletedAt      app.NullDateTime `json:"deleted_at"       db:"m.deleted_at"       gorm:"column:deleted_at"`
 }
 
 // EndPoint returns the Question end point, it used for cache key, etc.
@@ -22,7 +31,7 @@ func (Question) EndPoint() string {
 // TableVersion returns the versions of the Question table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Question) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291210"
 }
 
 // TableName returns the name of the Question table in the database.
@@ -50,6 +59,7 @@ func (m *Question) GetFilters() []map[string]any {
 
 // GetSorts returns the default sort of the Question data in the database, used for querying.
 func (m *Question) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.position", "direction": "asc"})
 	m.AddSort(map[string]any{"column": "m.updated_at", "direction": "desc"})
 	return m.Sorts
 }
@@ -92,3 +102,9 @@ type ParamDelete struct {
 	UseCaseHandler
 	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
 }
+
+// ParamReorderChoices is the expected parameters for reorder the choices of the Question data.
+type ParamReorderChoices struct {
+	ChoiceIDs []string       `json:"choice_ids" validate:"required,min=1,dive,uuid"`
+	Reason    app.NullString `json:"reason"     validate:"required"`
+}
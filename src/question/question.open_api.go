@@ -1,6 +1,9 @@
 package question
 
-import "github.com/survey-app/survey/app"
+import (
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/choice"
+)
 
 // OpenAPI is constructor for *openAPI, to autogenerate open api document.
 func OpenAPI() *OpenAPIOperation {
@@ -121,3 +124,31 @@ func (o *OpenAPIOperation) DeleteByID() *OpenAPIOperation {
 	o.Body = map[string]any{"application/json": &ParamDelete{}}
 	return o
 }
+
+// ReorderChoices is detail of `POST /api/v1/questions/{id}/choices/reorder` open api document component.
+func (o *OpenAPIOperation) ReorderChoices() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Tags = []string{"Question", "Choice"}
+	o.Summary = "Reorder Question Choices"
+	o.Description = "Use this method to rewrite the position of every choice of the Question by id"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamReorderChoices{}}
+	type ChoiceList struct {
+		app.ListModel
+		Data []choice.Choice `json:"results"`
+	}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &ChoiceList{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
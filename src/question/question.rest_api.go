@@ -2,11 +2,13 @@ package question
 
 import (
 	"net/http"
+	"net/url"
 
 	"github.com/gofiber/fiber/v2"
 	"grest.dev/grest"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/choice"
 )
 
 // REST returns a *RESTAPIHandler.
@@ -170,3 +172,32 @@ func (r *RESTAPIHandler) DeleteByID(c *fiber.Ctx) error {
 	}
 	return c.JSON(res)
 }
+
+// ReorderChoices is the REST API handler for `POST /api/v1/questions/{id}/choices/reorder`.
+func (r *RESTAPIHandler) ReorderChoices(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := ParamReorderChoices{}
+	err = grest.NewJSON(c.Body()).ToFlat().Unmarshal(&p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	err = r.UseCase.ReorderChoices(c.Params("id"), &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.Query.Get("is_skip_return") == "true" {
+		return c.JSON(map[string]any{"message": "Success"})
+	}
+	res, err := choice.UseCase(*r.UseCase.Ctx, url.Values{"question_id": []string{c.Params("id")}}).Get()
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res.SetLink(c)
+	if r.UseCase.IsFlat() {
+		return c.JSON(res)
+	}
+	return c.JSON(grest.NewJSON(res).ToStructured().Data)
+}
@@ -68,6 +68,24 @@ var tests = []struct {
 		expectedCode: http.StatusCreated,
 		expectedBody: `{"name":"Kilogram"}`,
 	},
+	{
+		description:  "Create Question with unsupported type",
+		method:       "POST",
+		path:         "/questions",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"question_text":"How old are you?","type":"slider"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Create Question with unknown bank question",
+		method:       "POST",
+		path:         "/questions",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"bank_question_id":"` + app.NewNullUUID().String + `"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
 	{
 		description:  "Get Question by ID",
 		method:       "GET",
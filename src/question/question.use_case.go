@@ -6,6 +6,10 @@ import (
 	"time"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/choice"
+	"github.com/survey-app/survey/src/row"
+	"github.com/survey-app/survey/src/section"
+	"github.com/survey-app/survey/src/survey"
 )
 
 // UseCase returns a UseCaseHandler for expected use case functional.
@@ -137,26 +141,112 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 		return err
 	}
 
+	// the undefined definition of the question referring to the question bank is taken from the bank question
+	bank, err := p.applyBankQuestion(Question{})
+	if err != nil {
+		return err
+	}
+
 	// set default value for undefined field
 	err = p.setDefaultValue(Question{})
 	if err != nil {
 		return err
 	}
 
+	// validate the validation rules of the question
+	err = survey.ValidateQuestionValidation(u.Ctx, p.Validation)
+	if err != nil {
+		return err
+	}
+
+	// validate the section of the question
+	err = u.validateSection(p.Question, Question{})
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic of the question
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+
+	// validate the code of the question
+	err = u.validateCode(p.Question, Question{})
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Question, Question{})
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
 		return app.NewError(http.StatusInternalServerError, err.Error())
 	}
 
+	// put the new question at the end of the survey when the position is undefined
+	if !p.Position.Valid {
+		maxPosition := int64(0)
+		err = tx.Model(&Question{}).
+			Where("survey_id = ? AND deleted_at IS NULL", p.SurveyId).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&maxPosition).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		p.Position.Set(maxPosition + 1)
+	}
+
 	// save data to db
 	err = tx.Model(&p).Create(&p).Error
 	if err != nil {
 		return app.NewError(http.StatusInternalServerError, err.Error())
 	}
 
+	// copy the choices of the bank question to the new question
+	choices := []choice.Choice{}
+	for _, c := range bank.Choises() {
+		ch := choice.Choice{}
+		ch.ID = app.NewNullUUID()
+		ch.QuestionId = p.ID
+		ch.ChoiseText = c.ChoiseText
+		ch.Position = c.Position
+		choices = append(choices, ch)
+	}
+	if len(choices) > 0 {
+		err = tx.Create(&choices).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		app.Cache().Invalidate(choice.Choice{}.EndPoint())
+	}
+
+	// copy the rows of the matrix bank question to the new question
+	rows := []row.Row{}
+	for _, r := range bank.QuestionRows() {
+		rw := row.Row{}
+		rw.ID = app.NewNullUUID()
+		rw.QuestionId = p.ID
+		rw.RowText = r.RowText
+		rw.Position = r.Position
+		rows = append(rows, rw)
+	}
+	if len(rows) > 0 {
+		err = tx.Create(&rows).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		app.Cache().Invalidate(row.Row{}.EndPoint())
+	}
+
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint())
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("POST", "create", p.ID.String, p)
@@ -190,6 +280,42 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 		return err
 	}
 
+	// validate the bank question referred by the question
+	_, err = p.applyBankQuestion(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the validation rules of the question
+	err = survey.ValidateQuestionValidation(u.Ctx, p.Validation)
+	if err != nil {
+		return err
+	}
+
+	// validate the section of the question
+	err = u.validateSection(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic of the question
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+
+	// validate the code of the question
+	err = u.validateCode(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Question, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -204,6 +330,7 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
@@ -237,6 +364,42 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 		return err
 	}
 
+	// validate the bank question referred by the question
+	_, err = p.applyBankQuestion(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the validation rules of the question
+	err = survey.ValidateQuestionValidation(u.Ctx, p.Validation)
+	if err != nil {
+		return err
+	}
+
+	// validate the section of the question
+	err = u.validateSection(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic of the question
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+
+	// validate the code of the question
+	err = u.validateCode(p.Question, old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Question, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -251,6 +414,7 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
@@ -278,6 +442,12 @@ func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
 		return err
 	}
 
+	// the structure of the published survey is not editable
+	err = u.validateEditable(Question{}, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -292,6 +462,7 @@ func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
 
 	// invalidate cache
 	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
 
 	// save history (user activity), send webhook, etc
 	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
@@ -306,5 +477,180 @@ func (u *UseCaseHandler) setDefaultValue(old Question) error {
 		u.ID = old.ID
 	}
 
+	if !old.ID.Valid && !u.Type.Valid {
+		u.Type.Set(survey.QuestionTypeSingleChoice)
+	}
+
+	if !old.ID.Valid && !u.IsRequired.Valid {
+		u.IsRequired.Set(false)
+	}
+
+	return nil
+}
+
+// applyBankQuestion returns the bank question referred by the question, it returns bad request error when the bank question does not exist.
+// The undefined text, type, config and validation of the new question are taken from the bank question,
+// the existing question only keeps the reference.
+func (u *UseCaseHandler) applyBankQuestion(old Question) (survey.BankQuestion, error) {
+	if !u.BankQuestionId.Valid || u.BankQuestionId.String == old.BankQuestionId.String {
+		return survey.BankQuestion{}, nil
+	}
+	b, err := survey.GetBankQuestion(u.Ctx, u.BankQuestionId.String)
+	if err != nil || old.ID.Valid {
+		return b, err
+	}
+	if !u.QuestionText.Valid {
+		u.QuestionText = b.QuestionText
+	}
+	if !u.Type.Valid {
+		u.Type = b.Type
+	}
+	if !u.Config.Valid {
+		u.Config = b.Config
+	}
+	if !u.Validation.Valid {
+		u.Validation = b.Validation
+	}
+	return b, nil
+}
+
+// validateSection validates that the section of the question exists and belongs to the survey of the question.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateSection(p, old Question) error {
+	if !p.SectionId.Valid {
+		return nil
+	}
+	surveyID := p.SurveyId
+	if !surveyID.Valid {
+		surveyID = old.SurveyId
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	sec := section.Section{}
+	err = app.First(tx, &sec, url.Values{"id": []string{p.SectionId.String}})
+	if err != nil {
+		return u.Ctx.InvalidReferenceError(err, sec.EndPoint(), "id", p.SectionId.String)
+	}
+	if sec.SurveyId.String != surveyID.String {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("section_not_in_survey", map[string]string{
+			"section_id": p.SectionId.String,
+		}))
+	}
+	return nil
+}
+
+// validateCode validates that the code of the question can be used as the placeholder and is unique on the survey.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateCode(p, old Question) error {
+	if !p.Code.Valid {
+		return nil
+	}
+	if !survey.IsValidQuestionCode(p.Code.String) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_question_code", map[string]string{"code": p.Code.String}))
+	}
+	surveyID := p.SurveyId
+	if !surveyID.Valid {
+		surveyID = old.SurveyId
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	count := int64(0)
+	err = tx.Model(&Question{}).
+		Where("survey_id = ? AND code = ? AND id != ? AND deleted_at IS NULL", surveyID, p.Code, p.ID).
+		Count(&count).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if count > 0 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("question_code_duplicated", map[string]string{"code": p.Code.String}))
+	}
+	return nil
+}
+
+// validateEditable validates that the survey of the question, before and after the change, is still editable.
+func (u UseCaseHandler) validateEditable(p, old Question) error {
+	err := survey.ValidateEditable(u.Ctx, old.SurveyId.String)
+	if err != nil {
+		return err
+	}
+	if p.SurveyId.Valid && p.SurveyId.String != old.SurveyId.String {
+		return survey.ValidateEditable(u.Ctx, p.SurveyId.String)
+	}
+	return nil
+}
+
+// ReorderChoices rewrites the position of the choices of the Question data for the specified ID,
+// the position follows the order of the choice ids on the parameters.
+func (u UseCaseHandler) ReorderChoices(id string, p *ParamReorderChoices) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("questions.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(Question{}, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the choice ids must contain every choice of the question exactly once
+	choiceIDs := []string{}
+	err = tx.Model(&choice.Choice{}).
+		Where("question_id = ? AND deleted_at IS NULL", old.ID).
+		Pluck("id", &choiceIDs).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if !survey.IsSameIDs(choiceIDs, p.ChoiceIDs) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_reorder", map[string]string{
+			"entity": u.Ctx.Trans(choice.Choice{}.EndPoint()),
+			"parent": u.Ctx.Trans(u.EndPoint()),
+		}))
+	}
+
+	// update data on the db
+	for i, choiceID := range p.ChoiceIDs {
+		err = tx.Model(&choice.Choice{}).Where("id = ?", choiceID).Update("position", i+1).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(choice.Choice{}.EndPoint())
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
 	return nil
 }
@@ -5,14 +5,18 @@ import "github.com/survey-app/survey/app"
 // Response is the main model of Response data. It provides a convenient interface for app.ModelInterface
 type Response struct {
 	app.Model
-	ID              app.NullUUID     `json:"id"               db:"m.id"               gorm:"column:id;primaryKey"`
-	SurveyId        app.NullUUID     `json:"survey_id"        db:"m.survey_id"        gorm:"column:survey_id"`
-	RespondentName  app.NullString   `json:"respondent_name"  db:"m.respondent_name"  gorm:"column:respondent_name"`
-	RespondentEmail app.NullString   `json:"respondent_email" db:"m.respondent_email" gorm:"column:respondent_email"`
-	IsActive        app.NullBool     `json:"is_active"        db:"m.is_active"        gorm:"column:is_active"`
-	CreatedAt       app.NullDateTime `json:"created_at"       db:"m.created_at"       gorm:"column:created_at"`
-	UpdatedAt       app.NullDateTime `json:"updated_at"       db:"m.updated_at"       gorm:"column:updated_at"`
-	DeletedAt       app.NullDateTime `json:"deleted_at"       db:"m.deleted_at"       gorm:"column:deleted_at"`
+	ID              app.NullUUID     `json:"id"                db:"m.id"                gorm:"column:id;primaryKey"`
+	SurveyId        app.NullUUID     `json:"survey_id"         db:"m.survey_id"         gorm:"column:survey_id"`
+	RespondentName  app.NullString   `json:"respondent_name"   db:"m.respondent_name"   gorm:"column:respondent_name"`
+	RespondentEmail app.NullString   `json:"respondent_email"  db:"m.respondent_email"  gorm:"column:respondent_email"`
+	IsActive        app.NullBool     `json:"is_active"         db:"m.is_active"         gorm:"column:is_active"`
+	HiddenFields    app.NullJSON     `json:"hidden_fields"     db:"m.hidden_fields"     gorm:"column:hidden_fields"`
+	SurveyVersionId app.NullUUID     `json:"survey_version_id" db:"m.survey_version_id" gorm:"column:survey_version_id"`
+	LastSectionId   app.NullUUID     `json:"last_section_id"   db:"m.last_section_id"   gorm:"column:last_section_id"`
+	CompletedAt     app.NullDateTime `json:"completed_at"      db:"m.completed_at"      gorm:"column:completed_at"`
+	CreatedAt       app.NullDateTime `json:"created_at"        db:"m.created_at"        gorm:"column:created_at"`
+	UpdatedAt       app.NullDateTime `json:"updated_at"        db:"m.updated_at"        gorm:"column:updated_at"`
+	DeletedAt       app.NullDateTime `json:"deleted_at"        db:"m.deleted_at"        gorm:"column:deleted_at"`
 }
 
 // EndPoint returns the Response end point, it used for cache key, etc.
@@ -23,7 +27,7 @@ func (Response) EndPoint() string {
 // TableVersion returns the versions of the Response table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Response) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291200"
 }
 
 // TableName returns the name of the Response table in the database.
@@ -68,6 +68,15 @@ var tests = []struct {
 		expectedCode: http.StatusCreated,
 		expectedBody: `{"name":"Kilogram"}`,
 	},
+	{
+		description:  "Create Response with unknown reference",
+		method:       "POST",
+		path:         "/responses",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"survey_id":"00000000-0000-0000-0000-000000000000","respondent_name":"John"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
 	{
 		description:  "Get Response by ID",
 		method:       "GET",
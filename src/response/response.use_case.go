@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
 )
 
 // UseCase returns a UseCaseHandler for expected use case functional.
@@ -143,12 +144,24 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 		return err
 	}
 
+	// validate the response against the survey definition
+	err = u.validateResponse(p.Response, Response{})
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
 		return app.NewError(http.StatusInternalServerError, err.Error())
 	}
 
+	// stamp the response with the survey version it answers
+	p.SurveyVersionId, err = survey.LatestVersionID(tx, p.SurveyId.String)
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
 	// save data to db
 	err = tx.Model(&p).Create(&p).Error
 	if err != nil {
@@ -190,6 +203,12 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 		return err
 	}
 
+	// validate the response against the survey definition
+	err = u.validateResponse(p.Response, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -237,6 +256,12 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 		return err
 	}
 
+	// validate the response against the survey definition
+	err = u.validateResponse(p.Response, old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -306,5 +331,42 @@ func (u *UseCaseHandler) setDefaultValue(old Response) error {
 		u.ID = old.ID
 	}
 
+	// the survey version is only stamped when the response is created
+	u.SurveyVersionId = old.SurveyVersionId
+
+	return nil
+}
+
+// validateResponse validates that the referenced survey exists, is not deleted and accepts the new response.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateResponse(p, old Response) error {
+	surveyID := p.SurveyId
+	if !surveyID.Valid {
+		surveyID = old.SurveyId
+	}
+	if !surveyID.Valid {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "survey_id"}))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	s := survey.Survey{}
+	err = app.First(tx, &s, url.Values{"id": []string{surveyID.String}})
+	if err != nil {
+		return u.Ctx.InvalidReferenceError(err, s.EndPoint(), "id", surveyID.String)
+	}
+
+	// the new response is only accepted by the published survey within its schedule and response limit
+	if !old.ID.Valid {
+		err = s.ValidateOpen(u.Ctx)
+		if err != nil {
+			return err
+		}
+		return s.ValidateLimit(u.Ctx)
+	}
 	return nil
 }
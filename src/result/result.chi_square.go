@@ -0,0 +1,105 @@
+package result
+
+import "math"
+
+// ChiSquare returns the Pearson's chi-square test statistic of the contingency table of counts along with its degrees of freedom
+// and p-value. The row or the column without any count is left out, so it does not add a degree of freedom.
+func ChiSquare(counts [][]int64) (float64, int64, float64) {
+	rowTotals, colTotals, total := []int64{}, []int64{}, int64(0)
+	for i, row := range counts {
+		rowTotals = append(rowTotals, 0)
+		for j, count := range row {
+			if j >= len(colTotals) {
+				colTotals = append(colTotals, 0)
+			}
+			rowTotals[i] += count
+			colTotals[j] += count
+			total += count
+		}
+	}
+	if total == 0 {
+		return 0, 0, 1
+	}
+
+	statistic := 0.0
+	for i, row := range counts {
+		for j, count := range row {
+			expected := float64(rowTotals[i]) * float64(colTotals[j]) / float64(total)
+			if expected > 0 {
+				statistic += (float64(count) - expected) * (float64(count) - expected) / expected
+			}
+		}
+	}
+	rows, cols := int64(0), int64(0)
+	for _, t := range rowTotals {
+		if t > 0 {
+			rows++
+		}
+	}
+	for _, t := range colTotals {
+		if t > 0 {
+			cols++
+		}
+	}
+	df := (rows - 1) * (cols - 1)
+	if df <= 0 {
+		return statistic, 0, 1
+	}
+	return statistic, df, ChiSquarePValue(statistic, df)
+}
+
+// ChiSquarePValue returns the probability of the chi-square distribution with df degrees of freedom to be at least x,
+// it is the regularized upper incomplete gamma function Q(df/2, x/2).
+func ChiSquarePValue(x float64, df int64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return upperIncompleteGamma(float64(df)/2, x/2)
+}
+
+// upperIncompleteGamma returns the regularized upper incomplete gamma function Q(a, x), computed by the series
+// when x < a+1 and by the continued fraction otherwise, as described in the Numerical Recipes.
+func upperIncompleteGamma(a, x float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+	lgamma, _ := math.Lgamma(a)
+	if x < a+1 {
+		sum, term := 1/a, 1/a
+		for n := 1; n <= maxIterations; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*epsilon {
+				break
+			}
+		}
+		return 1 - sum*math.Exp(-x+a*math.Log(x)-lgamma)
+	}
+
+	// the modified Lentz's method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n <= maxIterations; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma) * h
+}
@@ -0,0 +1,36 @@
+package result
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChiSquare(t *testing.T) {
+	pValues := []struct {
+		x        float64
+		df       int64
+		expected float64
+	}{
+		{3.841459, 1, 0.05},
+		{6.634897, 1, 0.01},
+		{5.991465, 2, 0.05},
+		{18.307038, 10, 0.05},
+		{0.5, 4, 0.973501},
+	}
+	for _, p := range pValues {
+		actual := ChiSquarePValue(p.x, p.df)
+		if math.Abs(actual-p.expected) > 1e-5 {
+			t.Errorf("expected p-value %v of chi-square %v with %d degrees of freedom, got %v", p.expected, p.x, p.df, actual)
+		}
+	}
+
+	statistic, df, pValue := ChiSquare([][]int64{{10, 20}, {30, 40}, {0, 0}})
+	if math.Abs(statistic-0.793651) > 1e-5 || df != 1 || math.Abs(pValue-0.373) > 1e-3 {
+		t.Errorf("expected chi-square 0.7937 with 1 degree of freedom and p-value 0.373, got %v, %v, %v", statistic, df, pValue)
+	}
+
+	_, df, pValue = ChiSquare([][]int64{{10, 20}})
+	if df != 0 || pValue != 1 {
+		t.Errorf("expected no degree of freedom and p-value 1 of a single row, got %v, %v", df, pValue)
+	}
+}
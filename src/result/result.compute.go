@@ -0,0 +1,109 @@
+package result
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// AnswerFilter keeps only the responses answering the question with one of the values,
+// the value is the id or the text of the choice, or the answer text.
+type AnswerFilter struct {
+	Question survey.Question
+	Values   []string
+}
+
+// ChoiseIDs returns the ids of the choices of the question matching the values by their id or their text.
+func (f AnswerFilter) ChoiseIDs() []string {
+	res := []string{}
+	for _, c := range f.Question.Choises {
+		for _, v := range f.Values {
+			if c.ID.String == v || strings.EqualFold(c.ChoiseText.String, v) {
+				res = append(res, c.ID.String)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// byQuestion returns the counts keyed by their question id.
+func byQuestion(counts []AnswerCount) map[string][]AnswerCount {
+	res := map[string][]AnswerCount{}
+	for _, c := range counts {
+		res[c.QuestionID.String] = append(res[c.QuestionID.String], c)
+	}
+	return res
+}
+
+// Compute returns the Result of the survey s from the data of its responses.
+func Compute(s survey.Survey, data Data) Result {
+	res := Result{SurveyID: s.ID.String, ResponseCount: data.ResponseCount, Questions: []QuestionResult{}}
+
+	// group the counts and the answers by question
+	questions, rows, choices, ranks := byQuestion(data.Questions), byQuestion(data.Rows), byQuestion(data.Choices), byQuestion(data.Ranks)
+	answersByQuestion := map[string][]Answer{}
+	for _, a := range data.Answers {
+		answersByQuestion[a.QuestionID.String] = append(answersByQuestion[a.QuestionID.String], a)
+	}
+
+	for _, q := range s.Questions {
+		qr := QuestionResult{
+			QuestionID:   q.ID.String,
+			Code:         q.Code.String,
+			QuestionText: q.QuestionText.String,
+			Type:         q.Type.String,
+		}
+		for _, c := range questions[q.ID.String] {
+			qr.ResponseCount += c.ResponseCount
+		}
+		qr.SkipCount = res.ResponseCount - qr.ResponseCount
+
+		questionAnswers := answersByQuestion[q.ID.String]
+		switch q.Type.String {
+		case survey.QuestionTypeSingleChoice, survey.QuestionTypeMultipleChoice:
+			qr.Choices = ComputeChoices(q.Choises, choices[q.ID.String], qr.ResponseCount)
+		case survey.QuestionTypeMatrix:
+			qr.Rows = ComputeRows(q, rows[q.ID.String], choices[q.ID.String])
+		case survey.QuestionTypeRanking:
+			qr.Ranking = ComputeRanking(q.Choises, ranks[q.ID.String])
+		case survey.QuestionTypeNumber, survey.QuestionTypeRating, survey.QuestionTypeNPS:
+			values := []float64{}
+			scores := []NPSScore{}
+			for _, a := range questionAnswers {
+				val, err := strconv.ParseFloat(strings.TrimSpace(a.AnswerText.String), 64)
+				if err != nil {
+					continue // the answer saved before the question type changed
+				}
+				values = append(values, val)
+				if q.Type.String == survey.QuestionTypeNPS {
+					scores = append(scores, NPSScore{Score: int64(val), Time: a.AnsweredAt.Time})
+				}
+			}
+			numeric := ComputeNumeric(values)
+			if q.Type.String == survey.QuestionTypeRating {
+				c, _ := survey.ParseQuestionConfig(q.Config)
+				min, max := c.Scale()
+				numeric = ComputeNumeric(values, min, max)
+			}
+			if q.Type.String == survey.QuestionTypeNPS {
+				numeric = ComputeNumeric(values, survey.NPSScaleMin, survey.NPSScaleMax)
+				nps := ComputeNPS(scores)
+				qr.NPS = &nps
+			}
+			qr.Numeric = &numeric
+		case survey.QuestionTypeShortText, survey.QuestionTypeLongText:
+			qr.TextSamples = data.TextSamples[q.ID.String]
+		default:
+			// the question without type (created before the question type exists) is answered by choice or by text
+			if len(q.Choises) > 0 {
+				qr.Choices = ComputeChoices(q.Choises, choices[q.ID.String], qr.ResponseCount)
+			} else {
+				qr.TextSamples = data.TextSamples[q.ID.String]
+			}
+		}
+		res.Questions = append(res.Questions, qr)
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+package result
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestCompute(t *testing.T) {
+	score, plan, reason := survey.Question{}, survey.Question{}, survey.Question{}
+	score.ID.Set("score")
+	score.Code.Set("nps_score")
+	score.Type.Set(survey.QuestionTypeNPS)
+	plan.ID.Set("plan")
+	plan.Type.Set(survey.QuestionTypeSingleChoice)
+	pro := survey.Choise{}
+	pro.ID.Set("pro")
+	pro.ChoiseText.Set("Pro")
+	plan.Choises = []survey.Choise{pro}
+	reason.ID.Set("reason")
+	reason.Type.Set(survey.QuestionTypeLongText)
+	s := survey.Survey{Questions: []survey.Question{score, plan, reason}}
+	s.ID.Set("survey")
+
+	// the data of 3 responses, r1 answers the score 10 and the Pro plan, r2 the score 3 and r3 the Pro plan
+	count := func(questionID, choiseID string, n, responses int64) AnswerCount {
+		c := AnswerCount{Count: n, ResponseCount: responses}
+		c.QuestionID.Set(questionID)
+		if choiseID != "" {
+			c.ChoiseID.Set(choiseID)
+		}
+		return c
+	}
+	answer := func(responseID, text string) Answer {
+		a := Answer{}
+		a.ResponseID.Set(responseID)
+		a.QuestionID.Set("score")
+		a.AnswerText.Set(text)
+		a.AnsweredAt.Set(time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC))
+		return a
+	}
+	data := Data{
+		ResponseCount: 3,
+		Questions:     []AnswerCount{count("score", "", 2, 2), count("plan", "", 2, 2), count("reason", "", 2, 2)},
+		Choices:       []AnswerCount{count("plan", "pro", 2, 2)},
+		Answers:       []Answer{answer("r1", "10"), answer("r2", "3")},
+		TextSamples:   map[string][]string{"reason": {"Fast support"}},
+	}
+
+	res := Compute(s, data)
+	if res.ResponseCount != 3 || len(res.Questions) != 3 {
+		t.Fatalf("expected 3 responses and 3 questions, got %+v", res)
+	}
+	nps := res.Questions[0]
+	if nps.ResponseCount != 2 || nps.SkipCount != 1 || nps.NPS == nil || nps.NPS.Score != 0 || len(nps.NPS.Daily) != 1 {
+		t.Errorf("expected the NPS 0 of 2 scores answered on a single day, got %+v", nps)
+	}
+	if nps.Numeric == nil || nps.Numeric.Mean != 6.5 || len(nps.Numeric.Histogram) != 11 {
+		t.Errorf("expected the mean 6.5 and a bin for each score, got %+v", nps.Numeric)
+	}
+	choices := res.Questions[1]
+	if choices.NPS != nil || len(choices.Choices) != 1 || choices.Choices[0].Count != 2 || choices.Choices[0].Percentage != 100 {
+		t.Errorf("expected the choice answered by all of the 2 responses, got %+v", choices)
+	}
+	texts := res.Questions[2]
+	if len(texts.TextSamples) != 1 || texts.TextSamples[0] != "Fast support" {
+		t.Errorf("expected the text sample, got %+v", texts)
+	}
+}
+
+func TestAnswerFilter(t *testing.T) {
+	plan := survey.Question{}
+	for _, id := range []string{"free", "pro", "team"} {
+		c := survey.Choise{}
+		c.ID.Set(id)
+		c.ChoiseText.Set(strings.ToUpper(id[:1]) + id[1:])
+		plan.Choises = append(plan.Choises, c)
+	}
+	f := AnswerFilter{Question: plan, Values: []string{"free", "PRO", "enterprise"}}
+	if ids := f.ChoiseIDs(); len(ids) != 2 || ids[0] != "free" || ids[1] != "pro" {
+		t.Errorf("expected the choices matching the values by their id or their text, got %v", ids)
+	}
+}
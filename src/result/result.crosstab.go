@@ -0,0 +1,186 @@
+package result
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// Crosstab is the contingency table of the answers of two questions, the row question and the column question,
+// along with the Pearson's chi-square test of independence between them. The chi-square test is null when either question
+// is a multiple choice question, since the response selecting multiple choices is counted on several cells.
+type Crosstab struct {
+	Row              CrosstabAxis     `json:"row"`
+	Column           CrosstabAxis     `json:"column"`
+	Cells            [][]CrosstabCell `json:"cells"` // indexed by the row category, then by the column category
+	RowTotals        []int64          `json:"row_totals"`
+	ColumnTotals     []int64          `json:"column_totals"`
+	Total            int64            `json:"total"`
+	ChiSquare        *float64         `json:"chi_square"`
+	DegreesOfFreedom *int64           `json:"degrees_of_freedom"`
+	PValue           *float64         `json:"p_value"`
+}
+
+// CrosstabAxis is the question of the row or the column of the Crosstab along with its categories.
+type CrosstabAxis struct {
+	QuestionID   string   `json:"question_id"`
+	Code         string   `json:"code,omitempty"`
+	QuestionText string   `json:"question_text"`
+	Type         string   `json:"type"`
+	Categories   []string `json:"categories"`
+}
+
+// CrosstabCell is the number of responses on a row category and a column category of the Crosstab.
+type CrosstabCell struct {
+	Count            int64   `json:"count"`
+	RowPercentage    float64 `json:"row_percentage"`
+	ColumnPercentage float64 `json:"column_percentage"`
+	TotalPercentage  float64 `json:"total_percentage"`
+}
+
+// IsCrosstabSupported reports whether the question q can be the row or the column of the Crosstab,
+// the choice question is categorized by its choices and the numeric question is categorized by bins.
+func IsCrosstabSupported(q survey.Question) bool {
+	switch q.Type.String {
+	case survey.QuestionTypeSingleChoice, survey.QuestionTypeMultipleChoice,
+		survey.QuestionTypeNumber, survey.QuestionTypeRating, survey.QuestionTypeNPS:
+		return true
+	case "":
+		return len(q.Choises) > 0
+	}
+	return false
+}
+
+// ComputeCrosstab returns the Crosstab of the row question and the column question from the answers,
+// only the responses answering both questions are counted. The response selecting multiple choices is counted on each of them.
+func ComputeCrosstab(row, col survey.Question, answers []Answer) Crosstab {
+	rowAxis, rowCategories := categorize(row, answers)
+	colAxis, colCategories := categorize(col, answers)
+	res := Crosstab{
+		Row:          rowAxis,
+		Column:       colAxis,
+		Cells:        [][]CrosstabCell{},
+		RowTotals:    make([]int64, len(rowAxis.Categories)),
+		ColumnTotals: make([]int64, len(colAxis.Categories)),
+	}
+
+	counts := [][]int64{}
+	for range rowAxis.Categories {
+		counts = append(counts, make([]int64, len(colAxis.Categories)))
+	}
+	for responseID, rowIndexes := range rowCategories {
+		for _, i := range rowIndexes {
+			for _, j := range colCategories[responseID] {
+				counts[i][j]++
+				res.RowTotals[i]++
+				res.ColumnTotals[j]++
+				res.Total++
+			}
+		}
+	}
+
+	for i, rowCounts := range counts {
+		cells := []CrosstabCell{}
+		for j, count := range rowCounts {
+			cells = append(cells, CrosstabCell{
+				Count:            count,
+				RowPercentage:    percentage(count, res.RowTotals[i]),
+				ColumnPercentage: percentage(count, res.ColumnTotals[j]),
+				TotalPercentage:  percentage(count, res.Total),
+			})
+		}
+		res.Cells = append(res.Cells, cells)
+	}
+
+	// the chi-square test requires each response counted once
+	if row.Type.String == survey.QuestionTypeMultipleChoice || col.Type.String == survey.QuestionTypeMultipleChoice {
+		return res
+	}
+	statistic, df, pValue := ChiSquare(counts)
+	statistic = round(statistic)
+	res.ChiSquare, res.DegreesOfFreedom, res.PValue = &statistic, &df, &pValue
+	return res
+}
+
+// categorize returns the axis of the question q along with the indexes of the categories answered by each response, keyed by the response id.
+// The choice question is categorized by its choices, the rating question by each value of its scale, the nps question by
+// detractor, passive and promoter, and the number question by the equal width bins of the histogram.
+func categorize(q survey.Question, answers []Answer) (CrosstabAxis, map[string][]int) {
+	axis := CrosstabAxis{QuestionID: q.ID.String, Code: q.Code.String, QuestionText: q.QuestionText.String, Type: q.Type.String, Categories: []string{}}
+	res := map[string][]int{}
+
+	// the answered value of each response, parsed as number for the numeric question
+	values := map[string][]float64{}
+	for _, a := range answers {
+		if a.QuestionID.String != q.ID.String || survey.IsChoiceQuestionType(q.Type.String) || q.Type.String == "" {
+			continue
+		}
+		val, err := strconv.ParseFloat(strings.TrimSpace(a.AnswerText.String), 64)
+		if err == nil {
+			values[a.ResponseID.String] = append(values[a.ResponseID.String], val)
+		}
+	}
+
+	switch q.Type.String {
+	case survey.QuestionTypeRating:
+		c, _ := survey.ParseQuestionConfig(q.Config)
+		min, max := c.Scale()
+		for v := min; v <= max; v++ {
+			axis.Categories = append(axis.Categories, strconv.FormatInt(v, 10))
+		}
+		for responseID, vals := range values {
+			for _, v := range vals {
+				if i := int(v) - int(min); i >= 0 && i < len(axis.Categories) {
+					res[responseID] = append(res[responseID], i)
+				}
+			}
+		}
+	case survey.QuestionTypeNPS:
+		axis.Categories = []string{"detractor", "passive", "promoter"}
+		for responseID, vals := range values {
+			for _, v := range vals {
+				for i, category := range axis.Categories {
+					if survey.NPSCategory(int64(v)) == category {
+						res[responseID] = append(res[responseID], i)
+					}
+				}
+			}
+		}
+	case survey.QuestionTypeNumber:
+		sorted := []float64{}
+		for _, vals := range values {
+			sorted = append(sorted, vals...)
+		}
+		if len(sorted) == 0 {
+			return axis, res
+		}
+		sort.Float64s(sorted)
+		bins := histogram(sorted)
+		for _, b := range bins {
+			axis.Categories = append(axis.Categories, strconv.FormatFloat(b.From, 'f', -1, 64)+" - "+strconv.FormatFloat(b.To, 'f', -1, 64))
+		}
+		for responseID, vals := range values {
+			for _, v := range vals {
+				i := sort.Search(len(bins), func(i int) bool { return v < bins[i].To })
+				if i == len(bins) {
+					i = len(bins) - 1
+				}
+				res[responseID] = append(res[responseID], i)
+			}
+		}
+	default:
+		indexes := map[string]int{}
+		for i, c := range q.Choises {
+			indexes[c.ID.String] = i
+			axis.Categories = append(axis.Categories, c.ChoiseText.String)
+		}
+		for _, a := range answers {
+			if i, ok := indexes[a.ChoiseID.String]; ok && a.QuestionID.String == q.ID.String {
+				res[a.ResponseID.String] = append(res[a.ResponseID.String], i)
+			}
+		}
+	}
+	return axis, res
+}
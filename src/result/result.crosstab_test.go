@@ -0,0 +1,84 @@
+package result
+
+import (
+	"testing"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestComputeCrosstab(t *testing.T) {
+	plan, score := survey.Question{}, survey.Question{}
+	plan.ID.Set("plan")
+	plan.Type.Set(survey.QuestionTypeSingleChoice)
+	for _, id := range []string{"free", "pro"} {
+		c := survey.Choise{}
+		c.ID.Set(id)
+		c.ChoiseText.Set(id)
+		plan.Choises = append(plan.Choises, c)
+	}
+	score.ID.Set("score")
+	score.Type.Set(survey.QuestionTypeNPS)
+	if !IsCrosstabSupported(plan) || !IsCrosstabSupported(score) {
+		t.Fatal("expected the choice and the nps question supported")
+	}
+	text := survey.Question{}
+	text.Type.Set(survey.QuestionTypeLongText)
+	if IsCrosstabSupported(text) {
+		t.Error("expected the text question unsupported")
+	}
+
+	answers := []Answer{}
+	for responseID, a := range map[string][]string{
+		"r1": {"free", "2"},
+		"r2": {"free", "5"},
+		"r3": {"free", "9"},
+		"r4": {"pro", "10"},
+		"r5": {"pro", "8"},
+		"r6": {"pro", ""},
+	} {
+		choice := Answer{}
+		choice.ResponseID.Set(responseID)
+		choice.QuestionID.Set("plan")
+		choice.ChoiseID.Set(a[0])
+		answers = append(answers, choice)
+		if a[1] != "" {
+			nps := Answer{}
+			nps.ResponseID.Set(responseID)
+			nps.QuestionID.Set("score")
+			nps.AnswerText.Set(a[1])
+			answers = append(answers, nps)
+		}
+	}
+
+	res := ComputeCrosstab(plan, score, answers)
+	if len(res.Row.Categories) != 2 || len(res.Column.Categories) != 3 || res.Column.Categories[0] != "detractor" {
+		t.Fatalf("expected the plan rows and the nps category columns, got %+v, %+v", res.Row, res.Column)
+	}
+	if res.Total != 5 || res.RowTotals[0] != 3 || res.RowTotals[1] != 2 || res.ColumnTotals[2] != 2 {
+		t.Errorf("expected 5 responses answering both questions, got %+v", res)
+	}
+	free := res.Cells[0]
+	if free[0].Count != 2 || free[0].RowPercentage != 66.67 || free[0].ColumnPercentage != 100 || free[0].TotalPercentage != 40 {
+		t.Errorf("expected 2 free plan detractors, got %+v", free[0])
+	}
+	if res.ChiSquare == nil || res.DegreesOfFreedom == nil || res.PValue == nil {
+		t.Fatalf("expected chi-square test of the single choice question, got %+v", res)
+	}
+	if *res.DegreesOfFreedom != 2 || *res.ChiSquare != 2.92 || *res.PValue < 0.23 || *res.PValue > 0.24 {
+		t.Errorf("expected chi-square 2.92 with 2 degrees of freedom and p-value 0.232, got %v, %v, %v", *res.ChiSquare, *res.DegreesOfFreedom, *res.PValue)
+	}
+
+	// the response selecting multiple choices is counted on each of them, so the chi-square test is left out
+	plan.Type.Set(survey.QuestionTypeMultipleChoice)
+	both := Answer{}
+	both.ResponseID.Set("r4")
+	both.QuestionID.Set("plan")
+	both.ChoiseID.Set("free")
+	res = ComputeCrosstab(plan, score, append(answers, both))
+	if res.Total != 6 || res.Cells[0][2].Count != 2 || res.Cells[1][2].Count != 1 {
+		t.Errorf("expected the response r4 counted on both of the plans, got %+v", res)
+	}
+	if res.ChiSquare != nil || res.DegreesOfFreedom != nil || res.PValue != nil {
+		t.Errorf("expected null chi-square test of the multiple choice question, got %v, %v, %v", res.ChiSquare, res.DegreesOfFreedom, res.PValue)
+	}
+}
@@ -0,0 +1,2 @@
+// result is a package related to the result of the survey, the statistics of the answers aggregated per question.
+package result
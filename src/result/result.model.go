@@ -0,0 +1,119 @@
+package result
+
+import "github.com/survey-app/survey/app"
+
+// Result is the main model of Result data, the statistics of the answers of a survey aggregated per question.
+type Result struct {
+	SurveyID      string           `json:"survey_id"`
+	ResponseCount int64            `json:"response_count"`
+	Questions     []QuestionResult `json:"questions"`
+}
+
+// QuestionResult is the statistics of the answers of a question.
+type QuestionResult struct {
+	QuestionID    string          `json:"question_id"`
+	Code          string          `json:"code,omitempty"`
+	QuestionText  string          `json:"question_text"`
+	Type          string          `json:"type"`
+	ResponseCount int64           `json:"response_count"`         // the number of responses answering the question
+	SkipCount     int64           `json:"skip_count"`             // the number of responses not answering the question
+	Choices       []ChoiceCount   `json:"choices,omitempty"`      // single_choice, multiple_choice
+	Rows          []RowResult     `json:"rows,omitempty"`         // matrix
+	Numeric       *NumericStats   `json:"numeric,omitempty"`      // number, rating, nps
+	NPS           *NPSResult      `json:"nps,omitempty"`          // nps
+	Ranking       []RankingChoice `json:"ranking,omitempty"`      // ranking, ordered by the borda score
+	TextSamples   []string        `json:"text_samples,omitempty"` // short_text, long_text, the latest answers first
+}
+
+// Response is the response of the survey used to compute the Result, the query filters of the Result are applied to it.
+// The responses are managed by the response package, this is the read only model.
+type Response struct {
+	app.Model
+	ID              app.NullUUID     `json:"id"                db:"m.id"                gorm:"column:id"`
+	SurveyId        app.NullUUID     `json:"survey_id"         db:"m.survey_id"         gorm:"column:survey_id"`
+	RespondentName  app.NullString   `json:"respondent_name"   db:"m.respondent_name"   gorm:"column:respondent_name"`
+	RespondentEmail app.NullString   `json:"respondent_email"  db:"m.respondent_email"  gorm:"column:respondent_email"`
+	HiddenFields    app.NullJSON     `json:"hidden_fields"     db:"m.hidden_fields"     gorm:"column:hidden_fields"`
+	SurveyVersionId app.NullUUID     `json:"survey_version_id" db:"m.survey_version_id" gorm:"column:survey_version_id"`
+	CompletedAt     app.NullDateTime `json:"completed_at"      db:"m.completed_at"      gorm:"column:completed_at"`
+	CreatedAt       app.NullDateTime `json:"created_at"        db:"m.created_at"        gorm:"column:created_at"`
+	DeletedAt       app.NullDateTime `json:"deleted_at"        db:"m.deleted_at,hide"   gorm:"column:deleted_at"`
+}
+
+// TableName returns the name of the responses table in the database.
+func (Response) TableName() string {
+	return "responses"
+}
+
+// TableAliasName returns the table alias name of the responses table, used for querying.
+func (Response) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the responses data in the database, used for querying.
+func (m *Response) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the responses data in the database, used for querying.
+func (m *Response) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the responses data in the database, used for querying.
+func (m *Response) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.created_at", "direction": "asc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the responses data in the database, used for querying.
+func (m *Response) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the responses schema, used for querying.
+func (m *Response) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// Answer is the answer of the Response used to compute the Result.
+// The answers are managed by the answer package, this is the read only model.
+type Answer struct {
+	ResponseID app.NullUUID     `json:"response_id" gorm:"column:response_id"`
+	QuestionID app.NullUUID     `json:"question_id" gorm:"column:question_id"`
+	ChoiseID   app.NullUUID     `json:"choise_id"   gorm:"column:choise_id"`
+	RowID      app.NullUUID     `json:"row_id"      gorm:"column:row_id"`
+	Rank       app.NullInt64    `json:"rank" gorm:"column:rank"`
+	AnswerText app.NullText     `json:"answer_text" gorm:"column:answer_text"`
+	AnsweredAt app.NullDateTime `json:"answered_at" gorm:"->;column:answered_at"` // the completed_at (or created_at) of the response, only selected for the numeric answers
+}
+
+// TableName returns the name of the answers table in the database.
+func (Answer) TableName() string {
+	return "answers"
+}
+
+// AnswerCount is the number of the answers of a group along with the number of the responses giving them,
+// the answers are grouped by the question and optionally by the row, the choice and the rank.
+type AnswerCount struct {
+	QuestionID    app.NullUUID  `json:"question_id"    gorm:"column:question_id"`
+	RowID         app.NullUUID  `json:"row_id"         gorm:"column:row_id"`
+	ChoiseID      app.NullUUID  `json:"choise_id"      gorm:"column:choise_id"`
+	Rank          app.NullInt64 `json:"rank"           gorm:"column:rank"`
+	Count         int64         `json:"count"          gorm:"column:count"`
+	ResponseCount int64         `json:"response_count" gorm:"column:response_count"`
+}
+
+// Data is the answers of the responses of a survey used to compute the Result, the counts are aggregated by the database
+// so only the answers of the numeric questions and the text samples are loaded.
+type Data struct {
+	ResponseCount int64               // the number of the responses
+	Questions     []AnswerCount       // grouped by the question
+	Rows          []AnswerCount       // grouped by the question and the row
+	Choices       []AnswerCount       // grouped by the question, the row and the choice
+	Ranks         []AnswerCount       // grouped by the question, the choice and the rank
+	Answers       []Answer            // the answers of the number, rating and nps questions
+	TextSamples   map[string][]string // the latest non empty text answers keyed by the question id, at most TextSampleSize answers
+}
@@ -0,0 +1,116 @@
+package result
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// NPS is the Net Promoter Score summary of the scores of an nps question.
+type NPS struct {
+	Count               int64   `json:"count"`
+	Promoters           int64   `json:"promoters"`
+	Passives            int64   `json:"passives"`
+	Detractors          int64   `json:"detractors"`
+	PromoterPercentage  float64 `json:"promoter_percentage"`
+	PassivePercentage   float64 `json:"passive_percentage"`
+	DetractorPercentage float64 `json:"detractor_percentage"`
+	Score               float64 `json:"score"` // the promoter percentage minus the detractor percentage, from -100 to 100
+}
+
+// NPSPeriod is the NPS of the scores answered on a period, the period is the date (2006-01-02) or the ISO week (2006-W01).
+type NPSPeriod struct {
+	Period string `json:"period"`
+	NPS
+}
+
+// NPSResult is the overall NPS of an nps question along with the NPS per day and per week.
+type NPSResult struct {
+	NPS
+	Daily  []NPSPeriod `json:"daily"`
+	Weekly []NPSPeriod `json:"weekly"`
+}
+
+// NPSScore is a score of an nps question along with the time it was answered.
+type NPSScore struct {
+	Score int64
+	Time  time.Time
+}
+
+// add counts the score into the category of the score.
+func (n *NPS) add(score int64) {
+	n.Count++
+	switch survey.NPSCategory(score) {
+	case "promoter":
+		n.Promoters++
+	case "passive":
+		n.Passives++
+	default:
+		n.Detractors++
+	}
+}
+
+// compute returns the NPS n with the percentages and the score computed from the counts.
+func (n NPS) compute() NPS {
+	if n.Count == 0 {
+		return n
+	}
+	n.PromoterPercentage = percentage(n.Promoters, n.Count)
+	n.PassivePercentage = percentage(n.Passives, n.Count)
+	n.DetractorPercentage = percentage(n.Detractors, n.Count)
+	n.Score = round(float64(n.Promoters-n.Detractors) * 100 / float64(n.Count))
+	return n
+}
+
+// ComputeNPS returns the overall NPS of the scores along with the NPS per day and per week in UTC, ordered by the period.
+func ComputeNPS(scores []NPSScore) NPSResult {
+	res := NPSResult{Daily: []NPSPeriod{}, Weekly: []NPSPeriod{}}
+	daily, weekly := map[string]*NPS{}, map[string]*NPS{}
+	for _, s := range scores {
+		res.NPS.add(s.Score)
+		t := s.Time.UTC()
+		day := t.Format(survey.DateFormat)
+		if daily[day] == nil {
+			daily[day] = &NPS{}
+		}
+		daily[day].add(s.Score)
+		year, w := t.ISOWeek()
+		week := fmt.Sprintf("%04d-W%02d", year, w)
+		if weekly[week] == nil {
+			weekly[week] = &NPS{}
+		}
+		weekly[week].add(s.Score)
+	}
+	res.NPS = res.NPS.compute()
+	res.Daily = npsPeriods(daily)
+	res.Weekly = npsPeriods(weekly)
+	return res
+}
+
+// npsPeriods returns the NPS of the periods ordered by the period.
+func npsPeriods(periods map[string]*NPS) []NPSPeriod {
+	res := []NPSPeriod{}
+	for period, n := range periods {
+		res = append(res, NPSPeriod{Period: period, NPS: n.compute()})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Period < res[j].Period
+	})
+	return res
+}
+
+// percentage returns the percentage of n from total, rounded to 2 decimal places.
+func percentage(n, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return round(float64(n) * 100 / float64(total))
+}
+
+// round returns f rounded to 2 decimal places.
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,37 @@
+package result
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeNPS(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	scores := []NPSScore{
+		{Score: 10, Time: monday},
+		{Score: 9, Time: monday},
+		{Score: 8, Time: monday.Add(24 * time.Hour)},
+		{Score: 0, Time: monday.Add(24 * time.Hour)},
+		{Score: 6, Time: monday.Add(7 * 24 * time.Hour)},
+	}
+
+	res := ComputeNPS(scores)
+	if res.Count != 5 || res.Promoters != 2 || res.Passives != 1 || res.Detractors != 2 {
+		t.Fatalf("expected 2 promoters, 1 passive and 2 detractors, got %+v", res.NPS)
+	}
+	if res.PromoterPercentage != 40 || res.PassivePercentage != 20 || res.DetractorPercentage != 40 || res.Score != 0 {
+		t.Errorf("expected 40%% promoters, 20%% passives, 40%% detractors and score 0, got %+v", res.NPS)
+	}
+
+	if len(res.Daily) != 3 || res.Daily[0].Period != "2026-10-12" || res.Daily[0].Score != 100 || res.Daily[1].Score != -50 || res.Daily[2].Score != -100 {
+		t.Errorf("expected the daily NPS 100, -50 and -100 ordered by date, got %+v", res.Daily)
+	}
+	if len(res.Weekly) != 2 || res.Weekly[0].Period != "2026-W42" || res.Weekly[0].Count != 4 || res.Weekly[0].Score != 25 || res.Weekly[1].Period != "2026-W43" {
+		t.Errorf("expected the weekly NPS of the week 42 and 43, got %+v", res.Weekly)
+	}
+
+	empty := ComputeNPS(nil)
+	if empty.Count != 0 || empty.Score != 0 || len(empty.Daily) != 0 || len(empty.Weekly) != 0 {
+		t.Errorf("expected empty NPS without scores, got %+v", empty)
+	}
+}
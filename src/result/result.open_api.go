@@ -0,0 +1,80 @@
+package result
+
+import "github.com/survey-app/survey/app"
+
+// OpenAPI is constructor for *openAPI, to autogenerate open api document.
+func OpenAPI() *OpenAPIOperation {
+	return &OpenAPIOperation{}
+}
+
+// OpenAPIOperation embed from app.OpenAPIOperation for simplicity, used for autogenerate open api document.
+type OpenAPIOperation struct {
+	app.OpenAPIOperation
+}
+
+// Base is common detail of results open api document component.
+func (o *OpenAPIOperation) Base() {
+	o.Tags = []string{"Result"}
+	o.HeaderParams = []map[string]any{{"$ref": "#/components/parameters/headerParam.Accept-Language"}}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Result{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	o.Securities = []map[string][]string{}
+}
+
+// GetBySurveyID is detail of `GET /api/v1/surveys/{id}/results` open api document component.
+func (o *OpenAPIOperation) GetBySurveyID() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Survey Results"
+	o.Description = "Use this method to get the results of the Survey by id, the statistics of the answers per question. " +
+		"Each question has the response and skip count, along with the choice counts and percentages (per row for the matrix question), " +
+		"the numeric stats and histogram of the number, rating and nps question, or the latest text answer samples. " +
+		"The NPS of the nps question is computed overall, per day and per week. " +
+		"The choices of the ranking question are ordered by the borda score, along with the average rank and the rank distribution. " +
+		"Filter the responses with the same query syntax as the responses list, " +
+		"or by the answer of other question with `answer.{question code or id}={choice id, choice text or answer text}`"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.QueryParams = []map[string]any{{"$ref": "#/components/parameters/queryParam.Any"}}
+	return o
+}
+
+// Crosstab is detail of `GET /api/v1/surveys/{id}/crosstab` open api document component.
+func (o *OpenAPIOperation) Crosstab() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Survey Crosstab"
+	o.Description = "Use this method to get the contingency table of the answers of two questions of the Survey by id, " +
+		"with the row and column percentages along with the chi-square test statistic and p-value, " +
+		"the chi-square test is null when either question is a multiple choice question. " +
+		"The choice question is categorized by its choices, the rating question by each value of its scale, " +
+		"the nps question by detractor, passive and promoter, and the number question by equal width bins. " +
+		"The responses are filtered the same way as the Survey results"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.QueryParams = []map[string]any{
+		{"name": "row", "in": "query", "required": true, "description": "The code or id of the question of the rows", "schema": map[string]any{"type": "string"}},
+		{"name": "col", "in": "query", "required": true, "description": "The code or id of the question of the columns", "schema": map[string]any{"type": "string"}},
+	}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Crosstab{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
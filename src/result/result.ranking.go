@@ -0,0 +1,59 @@
+package result
+
+import (
+	"sort"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// RankingChoice is the ranking summary of a choice of a ranking question.
+type RankingChoice struct {
+	ChoiceID     string  `json:"choice_id"`
+	ChoiceText   string  `json:"choice_text"`
+	AverageRank  float64 `json:"average_rank"` // the average rank of the choice, the lower the more preferred
+	Distribution []int64 `json:"distribution"` // the number of responses ranking the choice at the rank 1, 2, and so on
+	BordaScore   int64   `json:"borda_score"`  // the sum of the points of the choice, n - rank points for each of the rank of n choices
+	BordaRank    int64   `json:"borda_rank"`   // the rank of the choice ordered by the borda score
+	RankedCount  int64   `json:"ranked_count"` // the number of responses ranking the choice
+}
+
+// ComputeRanking returns the ranking summary of the choices from the counts of the answers of a ranking question grouped by
+// the choice and the rank, ordered by the borda score, the tie is ordered by the average rank then by the position of the choice.
+// The answer without rank or with the rank outside of the number of choices is ignored.
+func ComputeRanking(choices []survey.Choise, counts []AnswerCount) []RankingChoice {
+	res := []RankingChoice{}
+	n := int64(len(choices))
+	indexes := map[string]int{}
+	for i, c := range choices {
+		indexes[c.ID.String] = i
+		res = append(res, RankingChoice{ChoiceID: c.ID.String, ChoiceText: c.ChoiseText.String, Distribution: make([]int64, n)})
+	}
+
+	sums := make([]int64, n)
+	for _, c := range counts {
+		i, ok := indexes[c.ChoiseID.String]
+		if !ok || !c.Rank.Valid || c.Rank.Int64 < 1 || c.Rank.Int64 > n {
+			continue
+		}
+		res[i].RankedCount += c.Count
+		res[i].Distribution[c.Rank.Int64-1] += c.Count
+		res[i].BordaScore += (n - c.Rank.Int64) * c.Count
+		sums[i] += c.Rank.Int64 * c.Count
+	}
+	for i := range res {
+		if res[i].RankedCount > 0 {
+			res[i].AverageRank = round(float64(sums[i]) / float64(res[i].RankedCount))
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].BordaScore != res[j].BordaScore {
+			return res[i].BordaScore > res[j].BordaScore
+		}
+		return res[i].AverageRank < res[j].AverageRank
+	})
+	for i := range res {
+		res[i].BordaRank = int64(i + 1)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package result
+
+import (
+	"testing"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestComputeRanking(t *testing.T) {
+	choices := []survey.Choise{}
+	for _, id := range []string{"price", "quality", "speed"} {
+		c := survey.Choise{}
+		c.ID.Set(id)
+		c.ChoiseText.Set(id)
+		choices = append(choices, c)
+	}
+	// the counts of the rankings quality > price > speed, quality > speed > price and price > quality > speed,
+	// along with an answer of speed without rank
+	counts := []AnswerCount{}
+	for _, c := range []struct {
+		id          string
+		rank, count int64
+	}{{"quality", 1, 2}, {"quality", 2, 1}, {"price", 1, 1}, {"price", 2, 1}, {"price", 3, 1}, {"speed", 2, 1}, {"speed", 3, 2}, {"speed", 0, 1}} {
+		count := AnswerCount{Count: c.count, ResponseCount: c.count}
+		count.ChoiseID.Set(c.id)
+		if c.rank > 0 {
+			count.Rank.Set(c.rank)
+		}
+		counts = append(counts, count)
+	}
+
+	res := ComputeRanking(choices, counts)
+	if len(res) != 3 {
+		t.Fatalf("expected 3 choices, got %+v", res)
+	}
+	quality, price, speed := res[0], res[1], res[2]
+	if quality.ChoiceID != "quality" || quality.BordaScore != 5 || quality.BordaRank != 1 || quality.AverageRank != 1.33 {
+		t.Errorf("expected quality ranked first by borda score 5 with average rank 1.33, got %+v", quality)
+	}
+	if price.ChoiceID != "price" || price.BordaScore != 3 || price.AverageRank != 2 || price.Distribution[0] != 1 || price.Distribution[1] != 1 || price.Distribution[2] != 1 {
+		t.Errorf("expected price ranked second by borda score 3 once on each rank, got %+v", price)
+	}
+	if speed.ChoiceID != "speed" || speed.BordaScore != 1 || speed.RankedCount != 3 || speed.BordaRank != 3 {
+		t.Errorf("expected speed ranked last by borda score 1 ignoring the answer without rank, got %+v", speed)
+	}
+}
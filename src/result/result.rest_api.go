@@ -0,0 +1,55 @@
+package result
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/survey-app/survey/app"
+)
+
+// REST returns a *RESTAPIHandler.
+func REST() *RESTAPIHandler {
+	return &RESTAPIHandler{}
+}
+
+// RESTAPIHandler provides a convenient interface for Result REST API handler.
+type RESTAPIHandler struct {
+	UseCase UseCaseHandler
+}
+
+// injectDeps inject the dependencies of the Result REST API handler.
+func (r *RESTAPIHandler) injectDeps(c *fiber.Ctx) error {
+	ctx, ok := c.Locals(app.CtxKey).(*app.Ctx)
+	if !ok {
+		return app.NewError(http.StatusInternalServerError, "ctx is not found")
+	}
+	r.UseCase = UseCase(*ctx, app.ParseQuery(c))
+	return nil
+}
+
+// GetBySurveyID is the REST API handler for `GET /api/v1/surveys/{id}/results`.
+func (r *RESTAPIHandler) GetBySurveyID(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.GetBySurveyID(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
+
+// Crosstab is the REST API handler for `GET /api/v1/surveys/{id}/crosstab?row={question}&col={question}`.
+func (r *RESTAPIHandler) Crosstab(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.Crosstab(c.Params("id"), c.Query("row"), c.Query("col"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
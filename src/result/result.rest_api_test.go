@@ -0,0 +1,92 @@
+package result
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2/utils"
+
+	"github.com/survey-app/survey/app"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"results.detail",
+		"surveys.detail",
+	}))
+	app.Server().AddRoute("/surveys/:id/results", "GET", REST().GetBySurveyID, nil)
+	app.Server().AddRoute("/surveys/:id/crosstab", "GET", REST().Crosstab, nil)
+}
+
+// getTestSurveyID returns an available Survey ID.
+func getTestSurveyID() string {
+	return "todo"
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+		description:  "Get results of unknown Survey",
+		method:       "GET",
+		path:         "/surveys/00000000-0000-0000-0000-000000000000/results",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusNotFound,
+		expectedBody: `{"error":{"code":404}}`,
+	},
+	{
+		description:  "Get Survey results filtered by answer of unknown question",
+		method:       "GET",
+		path:         "/surveys/" + getTestSurveyID() + "/results?answer.unknown_question=10",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Get Survey crosstab without column question",
+		method:       "GET",
+		path:         "/surveys/" + getTestSurveyID() + "/crosstab?row=nps_score",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+}
+
+// TestResultREST tests the REST API of Result data with specified scenario.
+func TestResultREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
@@ -0,0 +1,163 @@
+package result
+
+import (
+	"math"
+	"sort"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+// TextSampleSize is the maximum number of the text answer samples of a question.
+const TextSampleSize = 10
+
+// ChoiceCount is the number of responses selecting a choice.
+type ChoiceCount struct {
+	ChoiceID   string  `json:"choice_id"`
+	ChoiceText string  `json:"choice_text"`
+	Count      int64   `json:"count"`
+	Percentage float64 `json:"percentage"` // from the responses answering the question (or the row), the sum is over 100 on multiple choice
+}
+
+// RowResult is the statistics of the answers of a row of a matrix question.
+type RowResult struct {
+	RowID         string        `json:"row_id"`
+	RowText       string        `json:"row_text"`
+	ResponseCount int64         `json:"response_count"`
+	Choices       []ChoiceCount `json:"choices"`
+}
+
+// NumericStats is the statistics of the numeric answers.
+type NumericStats struct {
+	Count     int64   `json:"count"`
+	Mean      float64 `json:"mean"`
+	Median    float64 `json:"median"`
+	StdDev    float64 `json:"std_dev"` // the sample standard deviation
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	Histogram []Bin   `json:"histogram"`
+}
+
+// Bin is a bin of the histogram, the number of values from From up to To, the last bin includes To.
+type Bin struct {
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Count int64   `json:"count"`
+}
+
+// ComputeChoices returns the number of the answers selecting each of the choices from their counts, the percentage is from the total.
+func ComputeChoices(choices []survey.Choise, counts []AnswerCount, total int64) []ChoiceCount {
+	res := []ChoiceCount{}
+	indexes := map[string]int{}
+	for i, c := range choices {
+		indexes[c.ID.String] = i
+		res = append(res, ChoiceCount{ChoiceID: c.ID.String, ChoiceText: c.ChoiseText.String})
+	}
+	for _, c := range counts {
+		if i, ok := indexes[c.ChoiseID.String]; ok {
+			res[i].Count += c.Count
+		}
+	}
+	for i := range res {
+		res[i].Percentage = percentage(res[i].Count, total)
+	}
+	return res
+}
+
+// ComputeRows returns the statistics of the answers of each of the rows of the matrix question q,
+// from the counts of the answers grouped by the row and the counts of the answers grouped by the row and the choice.
+func ComputeRows(q survey.Question, rows, choices []AnswerCount) []RowResult {
+	res := []RowResult{}
+	for _, r := range q.Rows {
+		respondents := int64(0)
+		for _, c := range rows {
+			if c.RowID.String == r.ID.String {
+				respondents += c.ResponseCount
+			}
+		}
+		rowChoices := []AnswerCount{}
+		for _, c := range choices {
+			if c.RowID.String == r.ID.String {
+				rowChoices = append(rowChoices, c)
+			}
+		}
+		res = append(res, RowResult{
+			RowID:         r.ID.String,
+			RowText:       r.RowText.String,
+			ResponseCount: respondents,
+			Choices:       ComputeChoices(q.Choises, rowChoices, respondents),
+		})
+	}
+	return res
+}
+
+// ComputeNumeric returns the statistics of the values. The histogram has a bin for each whole number of the scale
+// when the scale is given (rating and nps), otherwise the range of the values is split into equal width bins
+// by the Sturges' rule.
+func ComputeNumeric(values []float64, scale ...int64) NumericStats {
+	res := NumericStats{Count: int64(len(values)), Histogram: []Bin{}}
+	if len(scale) == 2 {
+		for v := scale[0]; v <= scale[1]; v++ {
+			res.Histogram = append(res.Histogram, Bin{From: float64(v), To: float64(v)})
+		}
+	}
+	if len(values) == 0 {
+		return res
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	res.Min, res.Max = sorted[0], sorted[n-1]
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(n)
+	res.Mean = round(mean)
+	res.Median = sorted[n/2]
+	if n%2 == 0 {
+		res.Median = round((sorted[n/2-1] + sorted[n/2]) / 2)
+	}
+	if n > 1 {
+		squares := 0.0
+		for _, v := range sorted {
+			squares += (v - mean) * (v - mean)
+		}
+		res.StdDev = round(math.Sqrt(squares / float64(n-1)))
+	}
+
+	if len(scale) == 2 {
+		for _, v := range sorted {
+			i := int(math.Round(v)) - int(scale[0])
+			if i >= 0 && i < len(res.Histogram) {
+				res.Histogram[i].Count++
+			}
+		}
+		return res
+	}
+	res.Histogram = histogram(sorted)
+	return res
+}
+
+// histogram returns the equal width bins of the sorted values, the number of bins is decided by the Sturges' rule.
+func histogram(sorted []float64) []Bin {
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return []Bin{{From: min, To: max, Count: int64(len(sorted))}}
+	}
+	k := int(math.Ceil(math.Log2(float64(len(sorted))))) + 1
+	width := (max - min) / float64(k)
+	res := []Bin{}
+	for i := 0; i < k; i++ {
+		res = append(res, Bin{From: round(min + float64(i)*width), To: round(min + float64(i+1)*width)})
+	}
+	res[k-1].To = max
+	for _, v := range sorted {
+		i := int((v - min) / width)
+		if i >= k {
+			i = k - 1
+		}
+		res[i].Count++
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package result
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestComputeNumeric(t *testing.T) {
+	res := ComputeNumeric([]float64{4, 1, 5, 4, 2, 4}, 1, 5)
+	if res.Count != 6 || res.Mean != 3.33 || res.Median != 4 || res.StdDev != 1.51 || res.Min != 1 || res.Max != 5 {
+		t.Errorf("expected mean 3.33, median 4, std dev 1.51 from 1 to 5, got %+v", res)
+	}
+	if len(res.Histogram) != 5 || res.Histogram[0].Count != 1 || res.Histogram[2].Count != 0 || res.Histogram[3].Count != 3 {
+		t.Errorf("expected a bin for each value of the scale, got %+v", res.Histogram)
+	}
+
+	res = ComputeNumeric([]float64{0, 10, 20, 30, 40, 50, 60, 70})
+	if res.Median != 35 || len(res.Histogram) != 4 || res.Histogram[0].From != 0 || res.Histogram[3].To != 70 {
+		t.Errorf("expected median 35 and 4 equal width bins from 0 to 70, got %+v", res)
+	}
+	for _, b := range res.Histogram {
+		if b.Count != 2 {
+			t.Errorf("expected 2 values on each bin, got %+v", res.Histogram)
+			break
+		}
+	}
+
+	res = ComputeNumeric(nil)
+	if res.Count != 0 || len(res.Histogram) != 0 {
+		t.Errorf("expected empty stats without values, got %+v", res)
+	}
+}
+
+func TestComputeRows(t *testing.T) {
+	q := survey.Question{}
+	for _, id := range []string{"good", "bad"} {
+		c := survey.Choise{}
+		c.ID.Set(id)
+		q.Choises = append(q.Choises, c)
+	}
+	for _, id := range []string{"speed", "price"} {
+		r := survey.Row{}
+		r.ID.Set(id)
+		q.Rows = append(q.Rows, r)
+	}
+	// the counts of the answers of r1 (speed good, price bad), r2 (speed good, price good) and r3 (speed bad)
+	rows, choices := []AnswerCount{}, []AnswerCount{}
+	for _, c := range [][]string{{"speed", "", "3"}, {"price", "", "2"}, {"speed", "good", "2"}, {"speed", "bad", "1"}, {"price", "good", "1"}, {"price", "bad", "1"}} {
+		count := AnswerCount{}
+		count.RowID.Set(c[0])
+		count.Count, _ = strconv.ParseInt(c[2], 10, 64)
+		count.ResponseCount = count.Count
+		if c[1] == "" {
+			rows = append(rows, count)
+			continue
+		}
+		count.ChoiseID.Set(c[1])
+		choices = append(choices, count)
+	}
+
+	res := ComputeRows(q, rows, choices)
+	if len(res) != 2 {
+		t.Fatalf("expected 2 rows, got %+v", res)
+	}
+	speed, price := res[0], res[1]
+	if speed.ResponseCount != 3 || speed.Choices[0].Count != 2 || speed.Choices[0].Percentage != 66.67 || speed.Choices[1].Percentage != 33.33 {
+		t.Errorf("expected speed answered good by 2 of 3 responses, got %+v", speed)
+	}
+	if price.ResponseCount != 2 || price.Choices[0].Percentage != 50 || price.Choices[1].Percentage != 50 {
+		t.Errorf("expected price answered good and bad by 1 of 2 responses each, got %+v", price)
+	}
+}
@@ -0,0 +1,267 @@
+package result
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gorm.io/gorm"
+	"grest.dev/grest"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// answerFilterPrefix is the prefix of the query key of the answer filter, for example `answer.nps_score=10`
+// keeps only the responses answering the question with code (or id) nps_score with 10.
+const answerFilterPrefix = "answer."
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Result use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// GetBySurveyID returns the Result data of the survey for the specified ID.
+// The responses are filtered by the query with the same syntax as the responses list,
+// and by the answer filters with the key `answer.{question code or id}`.
+func (u UseCaseHandler) GetBySurveyID(id string) (Result, error) {
+	res := Result{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("results.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get the survey along with its questions and choices
+	s, err := survey.UseCase(*u.Ctx).GetByID(id)
+	if err != nil {
+		return res, err
+	}
+
+	// take the answer filters out of the query filters of the responses
+	filters, err := u.answerFilters(s)
+	if err != nil {
+		return res, err
+	}
+
+	// aggregate the answers of the responses of the survey
+	data, err := u.find(s, filters)
+	if err != nil {
+		return res, err
+	}
+	return Compute(s, data), nil
+}
+
+// Crosstab returns the Crosstab data of the row question and the column question of the survey for the specified ID,
+// the question is referred by its code or id. The responses are filtered the same way as GetBySurveyID.
+func (u UseCaseHandler) Crosstab(id, rowRef, colRef string) (Crosstab, error) {
+	res := Crosstab{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("results.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get the survey along with its questions and choices
+	s, err := survey.UseCase(*u.Ctx).GetByID(id)
+	if err != nil {
+		return res, err
+	}
+
+	// validate the row question and the column question
+	questions := []survey.Question{}
+	for _, ref := range []string{rowRef, colRef} {
+		q, isFound := question(s, ref)
+		if !isFound {
+			return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("crosstab_question_not_found", map[string]string{
+				"question": ref,
+			}))
+		}
+		if !IsCrosstabSupported(q) {
+			return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("crosstab_question_unsupported", map[string]string{
+				"question": ref,
+				"type":     q.Type.String,
+			}))
+		}
+		questions = append(questions, q)
+	}
+	u.Query.Del("row")
+	u.Query.Del("col")
+
+	// take the answer filters out of the query filters of the responses
+	filters, err := u.answerFilters(s)
+	if err != nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// find the answers of the row question and the column question
+	query, err := u.answers(tx, s, filters)
+	if err != nil {
+		return res, err
+	}
+	answers := []Answer{}
+	err = query.Where("a.question_id IN ?", []string{questions[0].ID.String, questions[1].ID.String}).
+		Select("a.response_id", "a.question_id", "a.choise_id", "a.answer_text").
+		Find(&answers).Error
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	return ComputeCrosstab(questions[0], questions[1], answers), nil
+}
+
+// find returns the Data of the responses of the survey s filtered by the query and the answer filters.
+// The answers are counted by the database, only the answers of the numeric questions and the text samples are loaded.
+func (u UseCaseHandler) find(s survey.Survey, filters []AnswerFilter) (Data, error) {
+	data := Data{TextSamples: map[string][]string{}}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return data, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// count the responses
+	responses, err := u.responses(tx, s, filters)
+	if err != nil {
+		return data, err
+	}
+	err = tx.Table("(?) AS r", responses).Count(&data.ResponseCount).Error
+	if err != nil {
+		return data, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// count the answers grouped by the question, the row, the choice and the rank
+	answers, err := u.answers(tx, s, filters)
+	if err != nil {
+		return data, err
+	}
+	for _, group := range []struct {
+		counts    *[]AnswerCount
+		columns   []string
+		condition string
+	}{
+		{&data.Questions, []string{"a.question_id"}, "a.question_id IS NOT NULL"},
+		{&data.Rows, []string{"a.question_id", "a.row_id"}, "a.row_id IS NOT NULL"},
+		{&data.Choices, []string{"a.question_id", "a.row_id", "a.choise_id"}, "a.choise_id IS NOT NULL"},
+		{&data.Ranks, []string{"a.question_id", "a.choise_id", "a.rank"}, "a.choise_id IS NOT NULL AND a.rank IS NOT NULL"},
+	} {
+		columns := strings.Join(group.columns, ", ")
+		err = answers.Where(group.condition).
+			Select(columns + ", COUNT(*) AS count, COUNT(DISTINCT a.response_id) AS response_count").
+			Group(columns).
+			Find(group.counts).Error
+		if err != nil {
+			return data, app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// find the answers of the numeric questions and the latest text answers of the text questions
+	numericIDs := []string{}
+	for _, q := range s.Questions {
+		switch {
+		case q.Type.String == survey.QuestionTypeNumber || q.Type.String == survey.QuestionTypeRating || q.Type.String == survey.QuestionTypeNPS:
+			numericIDs = append(numericIDs, q.ID.String)
+		case q.Type.String == survey.QuestionTypeShortText || q.Type.String == survey.QuestionTypeLongText || (q.Type.String == "" && len(q.Choises) == 0):
+			samples := []string{}
+			err = answers.Joins("JOIN responses r ON r.id = a.response_id").
+				Where("a.question_id = ? AND TRIM(a.answer_text) <> ''", q.ID.String).
+				Order("r.created_at DESC").
+				Limit(TextSampleSize).
+				Pluck("TRIM(a.answer_text)", &samples).Error
+			if err != nil {
+				return data, app.NewError(http.StatusInternalServerError, err.Error())
+			}
+			data.TextSamples[q.ID.String] = samples
+		}
+	}
+	if len(numericIDs) > 0 {
+		err = answers.Joins("JOIN responses r ON r.id = a.response_id").
+			Where("a.question_id IN ?", numericIDs).
+			Select("a.response_id", "a.question_id", "a.answer_text", "COALESCE(r.completed_at, r.created_at) AS answered_at").
+			Find(&data.Answers).Error
+		if err != nil {
+			return data, app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	return data, nil
+}
+
+// responses returns the query of the ids of the responses of the survey s filtered by the query and the answer filters.
+func (u UseCaseHandler) responses(tx *gorm.DB, s survey.Survey, filters []AnswerFilter) (*gorm.DB, error) {
+	u.Query.Set("survey_id", s.ID.String)
+	u.Query.Set(grest.QueryDisablePagination, "true")
+	query, err := app.Query(tx, &Response{}, u.Query)
+	if err != nil {
+		return nil, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	query = query.Select("m.id")
+	for _, f := range filters {
+		query = query.Where("m.id IN (SELECT f.response_id FROM answers f WHERE f.deleted_at IS NULL AND f.question_id = ? AND (f.choise_id IN ? OR TRIM(f.answer_text) IN ?))",
+			f.Question.ID.String, f.ChoiseIDs(), f.Values)
+	}
+	return query, nil
+}
+
+// answers returns the query of the answers of the responses of the survey s filtered by the query and the answer filters,
+// the query is aliased as a and it is safe to be reused.
+func (u UseCaseHandler) answers(tx *gorm.DB, s survey.Survey, filters []AnswerFilter) (*gorm.DB, error) {
+	responses, err := u.responses(tx, s, filters)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Table("answers a").Where("a.deleted_at IS NULL AND a.response_id IN (?)", responses).Session(&gorm.Session{}), nil
+}
+
+// answerFilters removes the answer filters from the query and returns them,
+// it returns bad request error when the question of the filter is not found on the survey s.
+func (u UseCaseHandler) answerFilters(s survey.Survey) ([]AnswerFilter, error) {
+	res := []AnswerFilter{}
+	for key, values := range u.Query {
+		if !strings.HasPrefix(key, answerFilterPrefix) {
+			continue
+		}
+		u.Query.Del(key)
+		ref := strings.TrimPrefix(key, answerFilterPrefix)
+		q, isFound := question(s, ref)
+		if !isFound {
+			return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("result_filter_question_not_found", map[string]string{
+				"question": ref,
+			}))
+		}
+		res = append(res, AnswerFilter{Question: q, Values: values})
+	}
+	return res, nil
+}
+
+// question returns the question of the survey s with the code or the id ref.
+func question(s survey.Survey, ref string) (survey.Question, bool) {
+	for _, q := range s.Questions {
+		if q.ID.String == ref || (q.Code.Valid && q.Code.String == ref) {
+			return q, true
+		}
+	}
+	return survey.Question{}, false
+}
@@ -3,10 +3,18 @@ package src
 import (
 	"github.com/survey-app/survey/app"
 	"github.com/survey-app/survey/src/answer"
+	"github.com/survey-app/survey/src/bank"
 	"github.com/survey-app/survey/src/choice"
+	"github.com/survey-app/survey/src/export"
+	"github.com/survey-app/survey/src/importer"
 	"github.com/survey-app/survey/src/question"
 	"github.com/survey-app/survey/src/response"
+	"github.com/survey-app/survey/src/result"
+	"github.com/survey-app/survey/src/row"
+	"github.com/survey-app/survey/src/section"
+	"github.com/survey-app/survey/src/submission"
 	"github.com/survey-app/survey/src/survey"
+	"github.com/survey-app/survey/src/template"
 	// import : DONT REMOVE THIS COMMENT
 )
 
@@ -30,10 +38,37 @@ func (r *routerUtil) Configure() {
 
 	app.Server().AddRoute("/api/v1/surveys", "POST", survey.REST().Create, survey.OpenAPI().Create())
 	app.Server().AddRoute("/api/v1/surveys", "GET", survey.REST().Get, survey.OpenAPI().Get())
+	app.Server().AddRoute("/api/v1/surveys/import", "POST", importer.REST().Import, importer.OpenAPI().Import())
 	app.Server().AddRoute("/api/v1/surveys/{id}", "GET", survey.REST().GetByID, survey.OpenAPI().GetByID())
 	app.Server().AddRoute("/api/v1/surveys/{id}", "PUT", survey.REST().UpdateByID, survey.OpenAPI().UpdateByID())
 	app.Server().AddRoute("/api/v1/surveys/{id}", "PATCH", survey.REST().PartiallyUpdateByID, survey.OpenAPI().PartiallyUpdateByID())
 	app.Server().AddRoute("/api/v1/surveys/{id}", "DELETE", survey.REST().DeleteByID, survey.OpenAPI().DeleteByID())
+	app.Server().AddRoute("/api/v1/surveys/{id}/duplicate", "POST", survey.REST().Duplicate, survey.OpenAPI().Duplicate())
+	app.Server().AddRoute("/api/v1/surveys/{id}/definition", "GET", survey.REST().GetDefinition, survey.OpenAPI().GetDefinition())
+	app.Server().AddRoute("/api/v1/surveys/{id}/definition", "PUT", survey.REST().UpdateDefinition, survey.OpenAPI().UpdateDefinition())
+	app.Server().AddRoute("/api/v1/surveys/{id}/questions/reorder", "POST", survey.REST().ReorderQuestions, survey.OpenAPI().ReorderQuestions())
+	app.Server().AddRoute("/api/v1/surveys/{id}/publish", "POST", survey.REST().Publish, survey.OpenAPI().Publish())
+	app.Server().AddRoute("/api/v1/surveys/{id}/close", "POST", survey.REST().Close, survey.OpenAPI().Close())
+	app.Server().AddRoute("/api/v1/surveys/{id}/archive", "POST", survey.REST().Archive, survey.OpenAPI().Archive())
+	app.Server().AddRoute("/api/v1/surveys/{id}/reopen", "POST", survey.REST().Reopen, survey.OpenAPI().Reopen())
+	app.Server().AddRoute("/api/v1/surveys/{id}/revise", "POST", survey.REST().Revise, survey.OpenAPI().Revise())
+	app.Server().AddRoute("/api/v1/surveys/{id}/versions", "GET", survey.REST().GetVersions, survey.OpenAPI().GetVersions())
+	app.Server().AddRoute("/api/v1/surveys/{id}/versions/diff", "GET", survey.REST().DiffVersions, survey.OpenAPI().DiffVersions())
+	app.Server().AddRoute("/api/v1/surveys/{id}/results", "GET", result.REST().GetBySurveyID, result.OpenAPI().GetBySurveyID())
+	app.Server().AddRoute("/api/v1/surveys/{id}/crosstab", "GET", result.REST().Crosstab, result.OpenAPI().Crosstab())
+	app.Server().AddRoute("/api/v1/surveys/{id}/export", "GET", export.REST().Download, export.OpenAPI().Download())
+	app.Server().AddRoute("/api/v1/surveys/{id}/exports", "POST", export.REST().Create, export.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/exports/{id}", "GET", export.REST().GetByID, export.OpenAPI().GetByID())
+	app.Server().AddRoute("/api/v1/surveys/{id}/save-as-template", "POST", template.REST().SaveSurvey, template.OpenAPI().SaveSurvey())
+	app.Server().AddRoute("/api/v1/surveys/{id}/submissions", "POST", submission.REST().Create, submission.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/surveys/{id}/submissions/pages", "POST", submission.REST().SubmitPage, submission.OpenAPI().SubmitPage())
+
+	app.Server().AddRoute("/api/v1/sections", "POST", section.REST().Create, section.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/sections", "GET", section.REST().Get, section.OpenAPI().Get())
+	app.Server().AddRoute("/api/v1/sections/{id}", "GET", section.REST().GetByID, section.OpenAPI().GetByID())
+	app.Server().AddRoute("/api/v1/sections/{id}", "PUT", section.REST().UpdateByID, section.OpenAPI().UpdateByID())
+	app.Server().AddRoute("/api/v1/sections/{id}", "PATCH", section.REST().PartiallyUpdateByID, section.OpenAPI().PartiallyUpdateByID())
+	app.Server().AddRoute("/api/v1/sections/{id}", "DELETE", section.REST().DeleteByID, section.OpenAPI().DeleteByID())
 
 	app.Server().AddRoute("/api/v1/questions", "POST", question.REST().Create, question.OpenAPI().Create())
 	app.Server().AddRoute("/api/v1/questions", "GET", question.REST().Get, question.OpenAPI().Get())
@@ -41,6 +76,7 @@ func (r *routerUtil) Configure() {
 	app.Server().AddRoute("/api/v1/questions/{id}", "PUT", question.REST().UpdateByID, question.OpenAPI().UpdateByID())
 	app.Server().AddRoute("/api/v1/questions/{id}", "PATCH", question.REST().PartiallyUpdateByID, question.OpenAPI().PartiallyUpdateByID())
 	app.Server().AddRoute("/api/v1/questions/{id}", "DELETE", question.REST().DeleteByID, question.OpenAPI().DeleteByID())
+	app.Server().AddRoute("/api/v1/questions/{id}/choices/reorder", "POST", question.REST().ReorderChoices, question.OpenAPI().ReorderChoices())
 
 	app.Server().AddRoute("/api/v1/choices", "POST", choice.REST().Create, choice.OpenAPI().Create())
 	app.Server().AddRoute("/api/v1/choices", "GET", choice.REST().Get, choice.OpenAPI().Get())
@@ -49,12 +85,20 @@ func (r *routerUtil) Configure() {
 	app.Server().AddRoute("/api/v1/choices/{id}", "PATCH", choice.REST().PartiallyUpdateByID, choice.OpenAPI().PartiallyUpdateByID())
 	app.Server().AddRoute("/api/v1/choices/{id}", "DELETE", choice.REST().DeleteByID, choice.OpenAPI().DeleteByID())
 
+	app.Server().AddRoute("/api/v1/question_rows", "POST", row.REST().Create, row.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/question_rows", "GET", row.REST().Get, row.OpenAPI().Get())
+	app.Server().AddRoute("/api/v1/question_rows/{id}", "GET", row.REST().GetByID, row.OpenAPI().GetByID())
+	app.Server().AddRoute("/api/v1/question_rows/{id}", "PUT", row.REST().UpdateByID, row.OpenAPI().UpdateByID())
+	app.Server().AddRoute("/api/v1/question_rows/{id}", "PATCH", row.REST().PartiallyUpdateByID, row.OpenAPI().PartiallyUpdateByID())
+	app.Server().AddRoute("/api/v1/question_rows/{id}", "DELETE", row.REST().DeleteByID, row.OpenAPI().DeleteByID())
+
 	app.Server().AddRoute("/api/v1/responses", "POST", response.REST().Create, response.OpenAPI().Create())
 	app.Server().AddRoute("/api/v1/responses", "GET", response.REST().Get, response.OpenAPI().Get())
 	app.Server().AddRoute("/api/v1/responses/{id}", "GET", response.REST().GetByID, response.OpenAPI().GetByID())
 	app.Server().AddRoute("/api/v1/responses/{id}", "PUT", response.REST().UpdateByID, response.OpenAPI().UpdateByID())
 	app.Server().AddRoute("/api/v1/responses/{id}", "PATCH", response.REST().PartiallyUpdateByID, response.OpenAPI().PartiallyUpdateByID())
 	app.Server().AddRoute("/api/v1/responses/{id}", "DELETE", response.REST().DeleteByID, response.OpenAPI().DeleteByID())
+	app.Server().AddRoute("/api/v1/responses/{id}/next-page", "GET", submission.REST().NextPage, submission.OpenAPI().NextPage())
 
 	app.Server().AddRoute("/api/v1/answers", "POST", answer.REST().Create, answer.OpenAPI().Create())
 	app.Server().AddRoute("/api/v1/answers", "GET", answer.REST().Get, answer.OpenAPI().Get())
@@ -63,5 +107,20 @@ func (r *routerUtil) Configure() {
 	app.Server().AddRoute("/api/v1/answers/{id}", "PATCH", answer.REST().PartiallyUpdateByID, answer.OpenAPI().PartiallyUpdateByID())
 	app.Server().AddRoute("/api/v1/answers/{id}", "DELETE", answer.REST().DeleteByID, answer.OpenAPI().DeleteByID())
 
+	app.Server().AddRoute("/api/v1/survey_templates", "POST", template.REST().Create, template.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/survey_templates", "GET", template.REST().Get, template.OpenAPI().Get())
+	app.Server().AddRoute("/api/v1/survey_templates/{id}", "GET", template.REST().GetByID, template.OpenAPI().GetByID())
+	app.Server().AddRoute("/api/v1/survey_templates/{id}", "PUT", template.REST().UpdateByID, template.OpenAPI().UpdateByID())
+	app.Server().AddRoute("/api/v1/survey_templates/{id}", "PATCH", template.REST().PartiallyUpdateByID, template.OpenAPI().PartiallyUpdateByID())
+	app.Server().AddRoute("/api/v1/survey_templates/{id}", "DELETE", template.REST().DeleteByID, template.OpenAPI().DeleteByID())
+	app.Server().AddRoute("/api/v1/survey_templates/{id}/instantiate", "POST", template.REST().Instantiate, template.OpenAPI().Instantiate())
+
+	app.Server().AddRoute("/api/v1/bank_questions", "POST", bank.REST().Create, bank.OpenAPI().Create())
+	app.Server().AddRoute("/api/v1/bank_questions", "GET", bank.REST().Get, bank.OpenAPI().Get())
+	app.Server().AddRoute("/api/v1/bank_questions/{id}", "GET", bank.REST().GetByID, bank.OpenAPI().GetByID())
+	app.Server().AddRoute("/api/v1/bank_questions/{id}", "PUT", bank.REST().UpdateByID, bank.OpenAPI().UpdateByID())
+	app.Server().AddRoute("/api/v1/bank_questions/{id}", "PATCH", bank.REST().PartiallyUpdateByID, bank.OpenAPI().PartiallyUpdateByID())
+	app.Server().AddRoute("/api/v1/bank_questions/{id}", "DELETE", bank.REST().DeleteByID, bank.OpenAPI().DeleteByID())
+
 	// AddRoute : DONT REMOVE THIS COMMENT
 }
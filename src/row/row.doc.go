@@ -0,0 +1,2 @@
+// row is a package related to the row data of the matrix question.
+package row
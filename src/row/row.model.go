@@ -0,0 +1,96 @@
+package row
+
+import "github.com/survey-app/survey/app"
+
+// Row is the main model of Row data, a row item of the matrix question answered on the choices (columns) of the question.
+// It provides a convenient interface for app.ModelInterface
+type Row struct {
+	app.Model
+	ID         app.NullUUID     `json:"id"          db:"m.id"          gorm:"column:id;primaryKey"`
+	QuestionId app.NullUUID     `json:"question_id" db:"m.question_id" gorm:"column:question_id"`
+	RowText    app.NullText     `json:"row_text"    db:"m.row_text"    gorm:"column:row_text"`
+	Position   app.NullInt64    `json:"position"    db:"m.position"    gorm:"column:position"`
+	CreatedAt  app.NullDateTime `json:"created_at"  db:"m.created_at"  gorm:"column:created_at"`
+	UpdatedAt  app.NullDateTime `json:"updated_at"  db:"m.updated_at"  gorm:"column:updated_at"`
+	DeletedAt  app.NullDateTime `json:"deleted_at"  db:"m.deleted_at"  gorm:"column:deleted_at"`
+}
+
+// EndPoint returns the Row end point, it used for cache key, etc.
+func (Row) EndPoint() string {
+	return "question_rows"
+}
+
+// TableVersion returns the versions of the Row table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Row) TableVersion() string {
+	return "26.10.181600"
+}
+
+// TableName returns the name of the Row table in the database.
+func (Row) TableName() string {
+	return "question_rows"
+}
+
+// TableAliasName returns the table alias name of the Row table, used for querying.
+func (Row) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the Row data in the database, used for querying.
+func (m *Row) GetRelations() map[string]map[string]any {
+	// m.AddRelation("left", "users", "cu", []map[string]any{{"column1": "cu.id", "column2": "m.created_by_user_id"}})
+	// m.AddRelation("left", "users", "uu", []map[string]any{{"column1": "uu.id", "column2": "m.updated_by_user_id"}})
+	return m.Relations
+}
+
+// GetFilters returns the filter of the Row data in the database, used for querying.
+func (m *Row) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the Row data in the database, used for querying.
+func (m *Row) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.position", "direction": "asc"})
+	m.AddSort(map[string]any{"column": "m.updated_at", "direction": "desc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the Row data in the database, used for querying.
+func (m *Row) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the Row schema, used for querying.
+func (m *Row) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the Row schema in the open api documentation.
+func (Row) OpenAPISchemaName() string {
+	return "QuestionRow"
+}
+
+// ParamCreate is the expected parameters for create a new Row data.
+type ParamCreate struct {
+	UseCaseHandler
+}
+
+// ParamUpdate is the expected parameters for update the Row data.
+type ParamUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamPartiallyUpdate is the expected parameters for partially update the Row data.
+type ParamPartiallyUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamDelete is the expected parameters for delete the Row data.
+type ParamDelete struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
@@ -0,0 +1,147 @@
+package row
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2/utils"
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	tx := app.Test().Tx
+	app.DB().RegisterTable("main", Row{})
+	app.DB().MigrateTable(tx, "main", app.Setting{})
+	tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&Row{})
+
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"question_rows.detail",
+		"question_rows.list",
+		"question_rows.create",
+		"question_rows.edit",
+		"question_rows.delete",
+	}))
+	app.Server().AddRoute("/question_rows", "POST", REST().Create, nil)
+	app.Server().AddRoute("/question_rows", "GET", REST().Get, nil)
+	app.Server().AddRoute("/question_rows/:id", "GET", REST().GetByID, nil)
+	app.Server().AddRoute("/question_rows/:id", "PUT", REST().UpdateByID, nil)
+	app.Server().AddRoute("/question_rows/:id", "PATCH", REST().PartiallyUpdateByID, nil)
+	app.Server().AddRoute("/question_rows/:id", "DELETE", REST().DeleteByID, nil)
+}
+
+// getTestRowID returns an available Row ID.
+func getTestRowID() string {
+	return "todo"
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+		description:  "Get empty list of Row",
+		method:       "GET",
+		path:         "/question_rows",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"count":0,"results":[]}`,
+	},
+	{
+		description:  "Create Row with minimum payload",
+		method:       "POST",
+		path:         "/question_rows",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"name":"Kilogram"}`,
+		expectedCode: http.StatusCreated,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Get Row by ID",
+		method:       "GET",
+		path:         "/question_rows/" + getTestRowID(),
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Update Row by ID",
+		method:       "PUT",
+		path:         "/question_rows/" + getTestRowID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Update Row by ID","name":"KG"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"KG"}`,
+	},
+	{
+		description:  "Partially update Row by ID",
+		method:       "PATCH",
+		path:         "/question_rows/" + getTestRowID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Partially Update Row by ID","name":"Kilo Gram"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilo Gram"}`,
+	},
+	{
+		description:  "Delete Row by ID",
+		method:       "DELETE",
+		path:         "/question_rows/" + getTestRowID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Delete Row by ID"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"code":200}`,
+	},
+}
+
+// TestRowREST tests the REST API of Row data with specified scenario.
+func TestRowREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
+
+// BenchmarkRowREST tests the REST API of Row data with specified scenario.
+func BenchmarkRowREST(b *testing.B) {
+	b.ReportAllocs()
+	prepareTest(b)
+	for i := 0; i < b.N; i++ {
+		for _, test := range tests {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+			req.Header.Add("Authorization", "Bearer "+test.token)
+			req.Header.Add("Content-Type", "application/json")
+			app.Server().Test(req)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package row
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Row use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Row
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Row data for the specified ID.
+func (u UseCaseHandler) GetByID(id string) (Row, error) {
+	res := Row{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "." + id
+	app.Cache().Get(cacheKey, &res)
+	if res.ID.Valid {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	key := "id"
+	if !app.Validator().IsValid(id, "uuid") {
+		key = "code"
+	}
+	u.Query.Add(key, id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), key, id)
+	}
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Get returns the list of Row data.
+func (u UseCaseHandler) Get() (app.ListModel, error) {
+	res := app.ListModel{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.list")
+	if err != nil {
+		return res, err
+	}
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "?" + u.Query.Encode()
+	err = app.Cache().Get(cacheKey, &res)
+	if err == nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// set pagination info
+	res.Count,
+		res.PageContext.Page,
+		res.PageContext.PerPage,
+		res.PageContext.PageCount,
+		err = app.PaginationInfo(tx, &Row{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	// return data count if $per_page set to 0
+	if res.PageContext.PerPage == 0 {
+		return res, err
+	}
+
+	// find data
+	data, err := app.Find(tx, &Row{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	res.SetData(data, u.Query)
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Create creates a new data Row with specified parameters.
+func (u UseCaseHandler) Create(p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(Row{})
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Row, Row{})
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// put the new row at the end of the question when the position is undefined
+	if !p.Position.Valid {
+		maxPosition := int64(0)
+		err = tx.Model(&Row{}).
+			Where("question_id = ? AND deleted_at IS NULL", p.QuestionId).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&maxPosition).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		p.Position.Set(maxPosition + 1)
+	}
+
+	// save data to db
+	err = tx.Model(&p).Create(&p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "create", p.ID.String, p)
+	return nil
+}
+
+// UpdateByID updates the Row data for the specified ID with specified parameters.
+func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Row, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// PartiallyUpdateByID updates the Row data for the specified ID with specified parameters.
+func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Row, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// DeleteByID deletes the Row data for the specified ID.
+func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("question_rows.delete")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(Row{}, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Update("deleted_at", time.Now().UTC()).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// setDefaultValue set default value of undefined field when create or update Row data.
+func (u *UseCaseHandler) setDefaultValue(old Row) error {
+	if !old.ID.Valid {
+		u.ID = app.NewNullUUID()
+	} else {
+		u.ID = old.ID
+	}
+
+	return nil
+}
+
+// validateEditable validates that the survey of the question of the row, before and after the change, is still editable.
+func (u UseCaseHandler) validateEditable(p, old Row) error {
+	err := survey.ValidateQuestionEditable(u.Ctx, old.QuestionId.String)
+	if err != nil {
+		return err
+	}
+	if p.QuestionId.Valid && p.QuestionId.String != old.QuestionId.String {
+		return survey.ValidateQuestionEditable(u.Ctx, p.QuestionId.String)
+	}
+	return nil
+}
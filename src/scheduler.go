@@ -4,6 +4,8 @@ import (
 	"github.com/robfig/cron/v3"
 
 	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/export"
+	"github.com/survey-app/survey/src/survey"
 )
 
 func Scheduler() *schedulerUtil {
@@ -28,6 +30,8 @@ func (s *schedulerUtil) Configure() {
 
 	// add scheduler func here, for example :
 	// c.AddFunc("CRON_TZ=Asia/Jakarta 5 0 * * *", app.Auth().RemoveExpiredToken)
+	c.AddFunc("* * * * *", survey.RunSchedule)
+	c.AddFunc("* * * * *", export.RunSchedule)
 
 	c.Start()
 }
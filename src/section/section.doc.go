@@ -0,0 +1,2 @@
+// section is a package related to section data.
+package section
@@ -0,0 +1,98 @@
+package section
+
+import "github.com/survey-app/survey/app"
+
+// Section is the main model of Section data. It provides a convenient interface for app.ModelInterface
+type Section struct {
+	app.Model
+	ID          app.NullUUID     `json:"id"          db:"m.id"          gorm:"column:id;primaryKey"`
+	SurveyId    app.NullUUID     `json:"survey_id"   db:"m.survey_id"   gorm:"column:survey_id"`
+	Title       app.NullString   `json:"title"       db:"m.title"       gorm:"column:title"`
+	Description app.NullText     `json:"description" db:"m.description" gorm:"column:description"`
+	Position    app.NullInt64    `json:"position"    db:"m.position"    gorm:"column:position"`
+	VisibleIf   app.NullJSON     `json:"visible_if"  db:"m.visible_if"  gorm:"column:visible_if"`
+	Branches    app.NullJSON     `json:"branches"    db:"m.branches"    gorm:"column:branches"`
+	CreatedAt   app.NullDateTime `json:"created_at"  db:"m.created_at"  gorm:"column:created_at"`
+	UpdatedAt   app.NullDateTime `json:"updated_at"  db:"m.updated_at"  gorm:"column:updated_at"`
+	DeletedAt   app.NullDateTime `json:"deleted_at"  db:"m.deleted_at"  gorm:"column:deleted_at"`
+}
+
+// EndPoint returns the Section end point, it used for cache key, etc.
+func (Section) EndPoint() string {
+	return "sections"
+}
+
+// TableVersion returns the versions of the Section table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Section) TableVersion() string {
+	return "26.10.181230"
+}
+
+// TableName returns the name of the Section table in the database.
+func (Section) TableName() string {
+	return "sections"
+}
+
+// TableAliasName returns the table alias name of the Section table, used for querying.
+func (Section) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the Section data in the database, used for querying.
+func (m *Section) GetRelations() map[string]map[string]any {
+	// m.AddRelation("left", "users", "cu", []map[string]any{{"column1": "cu.id", "column2": "m.created_by_user_id"}})
+	// m.AddRelation("left", "users", "uu", []map[string]any{{"column1": "uu.id", "column2": "m.updated_by_user_id"}})
+	return m.Relations
+}
+
+// GetFilters returns the filter of the Section data in the database, used for querying.
+func (m *Section) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the Section data in the database, used for querying.
+func (m *Section) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.position", "direction": "asc"})
+	m.AddSort(map[string]any{"column": "m.updated_at", "direction": "desc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the Section data in the database, used for querying.
+func (m *Section) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the Section schema, used for querying.
+func (m *Section) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the Section schema in the open api documentation.
+func (Section) OpenAPISchemaName() string {
+	return "Section"
+}
+
+// ParamCreate is the expected parameters for create a new Section data.
+type ParamCreate struct {
+	UseCaseHandler
+}
+
+// ParamUpdate is the expected parameters for update the Section data.
+type ParamUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamPartiallyUpdate is the expected parameters for partially update the Section data.
+type ParamPartiallyUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamDelete is the expected parameters for delete the Section data.
+type ParamDelete struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
@@ -0,0 +1,156 @@
+package section
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2/utils"
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	tx := app.Test().Tx
+	app.DB().RegisterTable("main", Section{})
+	app.DB().MigrateTable(tx, "main", app.Setting{})
+	tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&Section{})
+
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"sections.detail",
+		"sections.list",
+		"sections.create",
+		"sections.edit",
+		"sections.delete",
+	}))
+	app.Server().AddRoute("/sections", "POST", REST().Create, nil)
+	app.Server().AddRoute("/sections", "GET", REST().Get, nil)
+	app.Server().AddRoute("/sections/:id", "GET", REST().GetByID, nil)
+	app.Server().AddRoute("/sections/:id", "PUT", REST().UpdateByID, nil)
+	app.Server().AddRoute("/sections/:id", "PATCH", REST().PartiallyUpdateByID, nil)
+	app.Server().AddRoute("/sections/:id", "DELETE", REST().DeleteByID, nil)
+}
+
+// getTestSectionID returns an available Section ID.
+func getTestSectionID() string {
+	return "todo"
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+		description:  "Get empty list of Section",
+		method:       "GET",
+		path:         "/sections",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"count":0,"results":[]}`,
+	},
+	{
+		description:  "Create Section with minimum payload",
+		method:       "POST",
+		path:         "/sections",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"name":"Kilogram"}`,
+		expectedCode: http.StatusCreated,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Create Section with unknown survey",
+		method:       "POST",
+		path:         "/sections",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"survey_id":"00000000-0000-0000-0000-000000000000","title":"About You"}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Get Section by ID",
+		method:       "GET",
+		path:         "/sections/" + getTestSectionID(),
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Update Section by ID",
+		method:       "PUT",
+		path:         "/sections/" + getTestSectionID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Update Section by ID","name":"KG"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"KG"}`,
+	},
+	{
+		description:  "Partially update Section by ID",
+		method:       "PATCH",
+		path:         "/sections/" + getTestSectionID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Partially Update Section by ID","name":"Kilo Gram"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilo Gram"}`,
+	},
+	{
+		description:  "Delete Section by ID",
+		method:       "DELETE",
+		path:         "/sections/" + getTestSectionID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Delete Section by ID"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"code":200}`,
+	},
+}
+
+// TestSectionREST tests the REST API of Section data with specified scenario.
+func TestSectionREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
+
+// BenchmarkSectionREST tests the REST API of Section data with specified scenario.
+func BenchmarkSectionREST(b *testing.B) {
+	b.ReportAllocs()
+	prepareTest(b)
+	for i := 0; i < b.N; i++ {
+		for _, test := range tests {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+			req.Header.Add("Authorization", "Bearer "+test.token)
+			req.Header.Add("Content-Type", "application/json")
+			app.Server().Test(req)
+		}
+	}
+}
@@ -0,0 +1,494 @@
+package section
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Section use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Section
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Section data for the specified ID.
+func (u UseCaseHandler) GetByID(id string) (Section, error) {
+	res := Section{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "." + id
+	app.Cache().Get(cacheKey, &res)
+	if res.ID.Valid {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	key := "id"
+	if !app.Validator().IsValid(id, "uuid") {
+		key = "code"
+	}
+	u.Query.Add(key, id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), key, id)
+	}
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Get returns the list of Section data.
+func (u UseCaseHandler) Get() (app.ListModel, error) {
+	res := app.ListModel{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.list")
+	if err != nil {
+		return res, err
+	}
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "?" + u.Query.Encode()
+	err = app.Cache().Get(cacheKey, &res)
+	if err == nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// set pagination info
+	res.Count,
+		res.PageContext.Page,
+		res.PageContext.PerPage,
+		res.PageContext.PageCount,
+		err = app.PaginationInfo(tx, &Section{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	// return data count if $per_page set to 0
+	if res.PageContext.PerPage == 0 {
+		return res, err
+	}
+
+	// find data
+	data, err := app.Find(tx, &Section{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	res.SetData(data, u.Query)
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Create creates a new data Section with specified parameters.
+func (u UseCaseHandler) Create(p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(Section{})
+	if err != nil {
+		return err
+	}
+
+	// validate the survey of the section
+	err = u.validateSection(p.Section, Section{})
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Section, Section{})
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic and the branches of the section
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+	err = survey.ValidateBranches(u.Ctx, p.Branches)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// put the new section at the end of the survey when the position is undefined
+	if !p.Position.Valid {
+		maxPosition := int64(0)
+		err = tx.Model(&Section{}).
+			Where("survey_id = ? AND deleted_at IS NULL", p.SurveyId).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&maxPosition).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		p.Position.Set(maxPosition + 1)
+	}
+
+	// the branches go to a later section of the survey or end the survey
+	err = u.validateBranchTargets(p.Section, Section{})
+	if err != nil {
+		return err
+	}
+
+	// save data to db
+	err = tx.Model(&p).Create(&p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "create", p.ID.String, p)
+	return nil
+}
+
+// UpdateByID updates the Section data for the specified ID with specified parameters.
+func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the survey of the section
+	err = u.validateSection(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic and the branches of the section
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+	err = survey.ValidateBranches(u.Ctx, p.Branches)
+	if err != nil {
+		return err
+	}
+	err = u.validateBranchTargets(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// PartiallyUpdateByID updates the Section data for the specified ID with specified parameters.
+func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the survey of the section
+	err = u.validateSection(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// validate the display logic and the branches of the section
+	err = survey.ValidateCondition(u.Ctx, p.VisibleIf)
+	if err != nil {
+		return err
+	}
+	err = survey.ValidateBranches(u.Ctx, p.Branches)
+	if err != nil {
+		return err
+	}
+	err = u.validateBranchTargets(p.Section, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// DeleteByID deletes the Section data for the specified ID.
+func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("sections.delete")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = u.validateEditable(Section{}, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Update("deleted_at", time.Now().UTC()).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the questions of the deleted section are moved out of the section
+	err = tx.Table("questions").Where("section_id = ?", old.ID).Update("section_id", nil).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(survey.Survey{}.EndPoint())
+	app.Cache().Invalidate("questions")
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// setDefaultValue set default value of undefined field when create or update Section data.
+func (u *UseCaseHandler) setDefaultValue(old Section) error {
+	if !old.ID.Valid {
+		u.ID = app.NewNullUUID()
+	} else {
+		u.ID = old.ID
+	}
+
+	return nil
+}
+
+// validateSection validates whether the survey of the section exists.
+func (u UseCaseHandler) validateSection(p, old Section) error {
+	surveyID := p.SurveyId
+	if !surveyID.Valid {
+		surveyID = old.SurveyId
+	}
+	if !surveyID.Valid {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "survey_id"}))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	s := survey.Survey{}
+	err = app.First(tx, &s, url.Values{"id": []string{surveyID.String}})
+	if err != nil {
+		return u.Ctx.InvalidReferenceError(err, s.EndPoint(), "id", surveyID.String)
+	}
+	return nil
+}
+
+// validateEditable validates that the survey of the section, before and after the change, is still editable.
+func (u UseCaseHandler) validateEditable(p, old Section) error {
+	err := survey.ValidateEditable(u.Ctx, old.SurveyId.String)
+	if err != nil {
+		return err
+	}
+	if p.SurveyId.Valid && p.SurveyId.String != old.SurveyId.String {
+		return survey.ValidateEditable(u.Ctx, p.SurveyId.String)
+	}
+	return nil
+}
+
+// validateBranchTargets validates that the branches of the section go to a later section of its survey or end the survey,
+// the undefined branches and position are taken from the old section.
+func (u UseCaseHandler) validateBranchTargets(p, old Section) error {
+	sec := survey.Section{}
+	sec.ID = p.ID
+	sec.Branches = p.Branches
+	if !sec.Branches.Valid {
+		sec.Branches = old.Branches
+	}
+	sec.Position = p.Position
+	if !sec.Position.Valid {
+		sec.Position = old.Position
+	}
+	surveyID := p.SurveyId
+	if !surveyID.Valid {
+		surveyID = old.SurveyId
+	}
+	if !sec.Branches.Valid || !surveyID.Valid {
+		return nil
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the other sections of the survey
+	sections := []survey.Section{}
+	err = tx.Model(&survey.Section{}).Select("id", "position").
+		Where("survey_id = ? AND deleted_at IS NULL AND id <> ?", surveyID, sec.ID).Find(&sections).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	return survey.ValidateBranchTargets(u.Ctx, append(sections, sec))
+}
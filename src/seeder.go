@@ -1,6 +1,10 @@
 package src
 
-import "github.com/survey-app/survey/app"
+import (
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+	"github.com/survey-app/survey/src/template"
+)
 
 func Seeder() *seederUtil {
 	if seeder == nil {
@@ -21,9 +25,18 @@ type seederUtil struct {
 }
 
 func (s *seederUtil) Configure() {
-
+	app.DB().RegisterSeeder("main", "surveys.status", survey.SeedStatus)
+	app.DB().RegisterSeeder("main", template.SeederKey, template.Seed)
 }
 
 func (s *seederUtil) Run() {
-
+	tx, err := app.DB().Conn("main")
+	if err != nil {
+		app.Logger().Fatal().Err(err).Send()
+	} else {
+		err = app.DB().RunSeeder(tx, "main", app.Setting{})
+	}
+	if err != nil {
+		app.Logger().Fatal().Err(err).Send()
+	}
 }
@@ -0,0 +1,2 @@
+// submission is a package related to submission data, a survey response along with all of its answers.
+package submission
@@ -0,0 +1,151 @@
+package submission
+
+import (
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// Submission is the main model of Submission data, a Response of a survey along with all of its Answers.
+// It provides a convenient interface for app.ModelInterface
+type Submission struct {
+	app.Model
+	ID              app.NullUUID     `json:"id"                db:"m.id"                gorm:"column:id;primaryKey"`
+	SurveyId        app.NullUUID     `json:"survey_id"         db:"m.survey_id"         gorm:"column:survey_id"`
+	RespondentName  app.NullString   `json:"respondent_name"   db:"m.respondent_name"   gorm:"column:respondent_name"`
+	RespondentEmail app.NullString   `json:"respondent_email"  db:"m.respondent_email"  gorm:"column:respondent_email"  validate:"omitempty,email"`
+	HiddenFields    app.NullJSON     `json:"hidden_fields"     db:"m.hidden_fields"     gorm:"column:hidden_fields"`
+	SurveyVersionId app.NullUUID     `json:"survey_version_id" db:"m.survey_version_id" gorm:"column:survey_version_id"`
+	LastSectionId   app.NullUUID     `json:"last_section_id"   db:"m.last_section_id"   gorm:"column:last_section_id"`
+	CompletedAt     app.NullDateTime `json:"completed_at"      db:"m.completed_at"      gorm:"column:completed_at"`
+	CreatedAt       app.NullDateTime `json:"created_at"        db:"m.created_at"        gorm:"column:created_at"`
+	DeletedAt       app.NullDateTime `json:"deleted_at"        db:"m.deleted_at,hide"   gorm:"column:deleted_at"`
+	Answers         []Answer         `json:"answers"           db:"response.id={id}"    gorm:"-"                        validate:"required,min=1,dive"`
+}
+
+// EndPoint returns the Submission end point, it used for cache key, etc.
+func (Submission) EndPoint() string {
+	return "submissions"
+}
+
+// TableVersion returns the versions of the responses table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Submission) TableVersion() string {
+	return "28.06.291200"
+}
+
+// TableName returns the name of the Submission table in the database, a submission is stored as a response.
+func (Submission) TableName() string {
+	return "responses"
+}
+
+// TableAliasName returns the table alias name of the Submission table, used for querying.
+func (Submission) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the Submission data in the database, used for querying.
+func (m *Submission) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the Submission data in the database, used for querying.
+func (m *Submission) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the Submission data in the database, used for querying.
+func (m *Submission) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.created_at", "direction": "desc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the Submission data in the database, used for querying.
+func (m *Submission) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the Submission schema, used for querying.
+func (m *Submission) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the Submission schema in the open api documentation.
+func (Submission) OpenAPISchemaName() string {
+	return "Submission"
+}
+
+// Answer is the answer of a question on the submitted survey.
+type Answer struct {
+	app.Model
+	ID         app.NullUUID     `json:"id"          db:"a.id"               gorm:"column:id"`
+	ResponseID app.NullUUID     `json:"response.id" db:"a.response_id,hide" gorm:"column:response_id"`
+	QuestionId app.NullUUID     `json:"question_id" db:"a.question_id"      gorm:"column:question_id" validate:"required"`
+	ChoiseId   app.NullUUID     `json:"choise_id"   db:"a.choise_id"        gorm:"column:choise_id"`
+	RowId      app.NullUUID     `json:"row_id"      db:"a.row_id"           gorm:"column:row_id"`
+	Rank       app.NullInt64    `json:"rank"        db:"a.rank"             gorm:"column:rank"`
+	AnswerText app.NullText     `json:"answer_text" db:"a.answer_text"      gorm:"column:answer_text"`
+	CreatedAt  app.NullDateTime `json:"created_at"  db:"a.created_at,hide"  gorm:"column:created_at"`
+}
+
+// TableVersion returns the versions of the answers table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Answer) TableVersion() string {
+	return "28.06.291200"
+}
+
+// TableName returns the name of the answers table in the database.
+func (Answer) TableName() string {
+	return "answers"
+}
+
+// TableAliasName returns the table alias name of the answers table, used for querying.
+func (Answer) TableAliasName() string {
+	return "a"
+}
+
+// GetRelations returns the relations of the answers data in the database, used for querying.
+func (m *Answer) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the answers data in the database, used for querying.
+func (m *Answer) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "a.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetFields returns list of the field of the answers data in the database, used for querying.
+func (m *Answer) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the answers schema, used for querying.
+func (m *Answer) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// ParamCreate is the expected parameters for submit a survey response along with all of its answers.
+type ParamCreate struct {
+	UseCaseHandler
+}
+
+// Page is the next page of a response to be answered, the section is nil when there is no more page to answer.
+type Page struct {
+	ResponseID  app.NullUUID    `json:"response_id"`
+	IsCompleted bool            `json:"is_completed"`
+	Section     *survey.Section `json:"section"`
+}
+
+// ParamSubmitPage is the expected parameters for submit a single page (section) of a survey response.
+// The response is started when the response id is undefined, otherwise the page is added to the existing response.
+type ParamSubmitPage struct {
+	ResponseID      app.NullUUID   `json:"response_id"`
+	SectionID       app.NullUUID   `json:"section_id"       validate:"required"`
+	RespondentName  app.NullString `json:"respondent_name"`
+	RespondentEmail app.NullString `json:"respondent_email" validate:"omitempty,email"`
+	HiddenFields    app.NullJSON   `json:"hidden_fields"`
+	Answers         []Answer       `json:"answers"          validate:"dive"`
+}
@@ -0,0 +1,83 @@
+package submission
+
+import "github.com/survey-app/survey/app"
+
+// OpenAPI is constructor for *openAPI, to autogenerate open api document.
+func OpenAPI() *OpenAPIOperation {
+	return &OpenAPIOperation{}
+}
+
+// OpenAPIOperation embed from app.OpenAPIOperation for simplicity, used for autogenerate open api document.
+type OpenAPIOperation struct {
+	app.OpenAPIOperation
+}
+
+// Base is common detail of submissions open api document component.
+func (o *OpenAPIOperation) Base() {
+	o.Tags = []string{"Submission"}
+	o.HeaderParams = []map[string]any{{"$ref": "#/components/parameters/headerParam.Accept-Language"}}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Submission{}}, // will auto create schema $ref: '#/components/schemas/Submission' if not exists
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	o.Securities = []map[string][]string{}
+}
+
+// Create is detail of `POST /api/v1/surveys/{id}/submissions` open api document component.
+func (o *OpenAPIOperation) Create() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Submit Survey Response"
+	o.Description = "Use this method to submit a response of the survey along with all of its answers at once"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamCreate{}}
+	return o
+}
+
+// SubmitPage is detail of `POST /api/v1/surveys/{id}/submissions/pages` open api document component.
+func (o *OpenAPIOperation) SubmitPage() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Submit Survey Page"
+	o.Description = "Use this method to submit the answers of a single page (section) of the survey. " +
+		"Leave the response_id empty to start a new response, then use the returned id as the response_id of the next pages. " +
+		"The response is completed when the last page is submitted."
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamSubmitPage{}}
+	return o
+}
+
+// NextPage is detail of `GET /api/v1/responses/{id}/next-page` open api document component.
+func (o *OpenAPIOperation) NextPage() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Next Page of Response"
+	o.Description = "Use this method to get the next page of the response to be answered. " +
+		"The page only contains the visible questions, the placeholders such as {{question_code}} and {{hidden.field_name}} " +
+		"in the question text and the choice text are replaced with the saved answers and the hidden fields of the response."
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Page{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
@@ -0,0 +1,435 @@
+package submission
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/answer"
+	"github.com/survey-app/survey/src/response"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Submission use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Submission
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Submission data for the specified response ID.
+func (u UseCaseHandler) GetByID(id string) (Submission, error) {
+	res := Submission{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("submissions.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "." + id
+	app.Cache().Get(cacheKey, &res)
+	if res.ID.Valid {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	u.Query.Add("id", id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), "id", id)
+	}
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Create submits a new response of the specified survey along with all of its answers.
+// The response and the answers are saved on the same db transaction, so nothing is saved when one of them is invalid.
+func (u UseCaseHandler) Create(surveyID string, p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("submissions.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get the survey along with its questions and choices
+	s, err := survey.UseCase(*u.Ctx).GetByID(surveyID)
+	if err != nil {
+		return err
+	}
+	err = s.ValidateOpen(u.Ctx)
+	if err != nil {
+		return err
+	}
+
+	// evaluate the survey logic, the hidden questions are not required and their answers are ignored
+	v := s.Evaluate(answerValues(p.Answers))
+	p.Answers = visibleAnswers(p.Answers, v)
+
+	// validate every answer against the survey questions and choices
+	err = u.validateAnswers(v.VisibleQuestions(s.Questions), p.Answers)
+	if err != nil {
+		return err
+	}
+
+	// save the response
+	r := response.ParamCreate{}
+	r.SurveyId = s.ID
+	r.RespondentName = p.RespondentName
+	r.RespondentEmail = p.RespondentEmail
+	r.HiddenFields = p.HiddenFields
+	r.CreatedAt = app.NewNullDateTime(time.Now().UTC())
+	r.CompletedAt = r.CreatedAt
+	for _, sec := range s.Sections {
+		if v.Sections[sec.ID.String] {
+			r.LastSectionId = sec.ID
+		}
+	}
+	err = response.UseCase(*u.Ctx).Create(&r)
+	if err != nil {
+		return err
+	}
+	p.ID = r.ID
+	p.SurveyId = r.SurveyId
+	p.CreatedAt = r.CreatedAt
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// save the answers
+	for i := range p.Answers {
+		p.Answers[i].ID = app.NewNullUUID()
+		p.Answers[i].ResponseID = p.ID
+		p.Answers[i].CreatedAt = p.CreatedAt
+	}
+	if len(p.Answers) > 0 {
+		err = tx.Create(&p.Answers).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+	app.Cache().Invalidate(answer.Answer{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "create", p.ID.String, p)
+	return nil
+}
+
+// SubmitPage submits the answers of a single page (section) of the specified survey and returns the response id.
+// Only the visible questions of the submitted page are validated, the previous answers of the page are replaced,
+// and the response is completed when there is no more visible page after the submitted page.
+func (u UseCaseHandler) SubmitPage(surveyID string, p *ParamSubmitPage) (string, error) {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("submissions.create")
+	if err != nil {
+		return "", err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return "", err
+	}
+
+	// get the survey along with its sections, questions and choices
+	s, err := survey.UseCase(*u.Ctx).GetByID(surveyID)
+	if err != nil {
+		return "", err
+	}
+	err = s.ValidateOpen(u.Ctx)
+	if err != nil {
+		return "", err
+	}
+	sec, ok := s.Section(p.SectionID.String)
+	if !ok {
+		return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("section_not_in_survey", map[string]string{
+			"section_id": p.SectionID.String,
+		}))
+	}
+
+	for _, a := range p.Answers {
+		if !sec.HasQuestion(a.QuestionId.String) {
+			return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("question_not_in_section", map[string]string{
+				"question_id": a.QuestionId.String,
+			}))
+		}
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get the answers of the other pages when continue the existing response
+	questionIDs := []string{}
+	for _, q := range sec.Questions {
+		questionIDs = append(questionIDs, q.ID.String)
+	}
+	saved := []Answer{}
+	if p.ResponseID.Valid {
+		old := Submission{}
+		err = app.First(tx, &old, url.Values{"id": []string{p.ResponseID.String}})
+		if err != nil {
+			return "", u.Ctx.InvalidReferenceError(err, response.Response{}.EndPoint(), "id", p.ResponseID.String)
+		}
+		if old.SurveyId.String != s.ID.String {
+			return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_reference", map[string]string{
+				"entity": u.Ctx.Trans(response.Response{}.EndPoint()),
+				"key":    u.Ctx.Trans("id"),
+				"value":  p.ResponseID.String,
+			}))
+		}
+		if old.CompletedAt.Valid {
+			return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("response_already_completed"))
+		}
+		for _, a := range old.Answers {
+			if !sec.HasQuestion(a.QuestionId.String) {
+				saved = append(saved, a)
+			}
+		}
+	}
+
+	// evaluate the survey logic, the hidden questions are not required and their answers are ignored
+	v := s.Evaluate(answerValues(append(saved, p.Answers...)))
+	if !v.Sections[sec.ID.String] {
+		return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("section_hidden", map[string]string{
+			"section_id": sec.ID.String,
+		}))
+	}
+	p.Answers = visibleAnswers(p.Answers, v)
+
+	// validate every answer against the questions and choices of the page
+	err = u.validateAnswers(v.VisibleQuestions(sec.Questions), p.Answers)
+	if err != nil {
+		return "", err
+	}
+
+	// start a new response or continue the existing one
+	now := app.NewNullDateTime(time.Now().UTC())
+	responseID := p.ResponseID
+	if !responseID.Valid {
+		r := response.ParamCreate{}
+		r.SurveyId = s.ID
+		r.RespondentName = p.RespondentName
+		r.RespondentEmail = p.RespondentEmail
+		r.HiddenFields = p.HiddenFields
+		r.CreatedAt = now
+		err = response.UseCase(*u.Ctx).Create(&r)
+		if err != nil {
+			return "", err
+		}
+		responseID = r.ID
+	} else if len(questionIDs) > 0 {
+		// the page is submitted again, replace the previous answers of the page
+		err = tx.Model(&Answer{}).
+			Where("response_id = ? AND question_id IN ? AND deleted_at IS NULL", responseID, questionIDs).
+			Update("deleted_at", now).Error
+		if err != nil {
+			return "", app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// save the answers
+	if len(p.Answers) > 0 {
+		for i := range p.Answers {
+			p.Answers[i].ID = app.NewNullUUID()
+			p.Answers[i].ResponseID = responseID
+			p.Answers[i].CreatedAt = now
+		}
+		err = tx.Create(&p.Answers).Error
+		if err != nil {
+			return "", app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// keep the progress of the response, the response is completed on the last visible page
+	progress := map[string]any{"last_section_id": sec.ID, "updated_at": now}
+	if _, hasNext := s.NextSection(sec.ID.String, v); !hasNext {
+		progress["completed_at"] = now
+
+		// the answers of the pages which are hidden by the final answers are ignored
+		hiddenIDs := v.HiddenQuestionIDs()
+		if len(hiddenIDs) > 0 {
+			err = tx.Model(&Answer{}).
+				Where("response_id = ? AND question_id IN ? AND deleted_at IS NULL", responseID, hiddenIDs).
+				Update("deleted_at", now).Error
+			if err != nil {
+				return "", app.NewError(http.StatusInternalServerError, err.Error())
+			}
+		}
+	}
+	err = tx.Model(&Submission{}).Where("id = ?", responseID).Updates(progress).Error
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), responseID.String)
+	app.Cache().Invalidate(response.Response{}.EndPoint(), responseID.String)
+	app.Cache().Invalidate(answer.Answer{}.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "submit_page", responseID.String, p)
+	return responseID.String, nil
+}
+
+// NextPage returns the next page of the response for the specified ID to be answered, the page only contains the visible questions
+// and the placeholders of the question text and the choice text are replaced with the saved answers and the hidden fields.
+// The survey without section is answered in a single page.
+func (u UseCaseHandler) NextPage(id string) (Page, error) {
+	res := Page{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("submissions.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get the response along with its answers and the survey
+	sub, err := u.GetByID(id)
+	if err != nil {
+		return res, err
+	}
+	s, err := survey.UseCase(*u.Ctx).GetByID(sub.SurveyId.String)
+	if err != nil {
+		return res, err
+	}
+	res.ResponseID = sub.ID
+	res.IsCompleted = sub.CompletedAt.Valid
+	if res.IsCompleted {
+		return res, nil
+	}
+
+	// find the next visible page based on the saved answers
+	v := s.Evaluate(answerValues(sub.Answers))
+	next, ok := survey.Section{}, false
+	switch {
+	case len(s.Sections) == 0:
+		next.Title = s.Title
+		if s.Description.Valid {
+			next.Description.Set(s.Description.String)
+		}
+		next.Questions = s.Questions
+		ok = true
+	case !sub.LastSectionId.Valid:
+		next, ok = s.FirstSection(v)
+	default:
+		next, ok = s.NextSection(sub.LastSectionId.String, v)
+	}
+	if !ok {
+		return res, nil
+	}
+
+	// render the visible questions of the page
+	next.Questions = v.VisibleQuestions(next.Questions)
+	next = next.Render(s.TemplateValues(answerValues(visibleAnswers(sub.Answers, v)), sub.HiddenFields))
+	res.Section = &next
+	return res, nil
+}
+
+// validateAnswers validates every answer against the questions, the errors are returned per question
+// on the error detail with the question id as the key.
+func (u UseCaseHandler) validateAnswers(questions []survey.Question, answers []Answer) error {
+	questionIDs := map[string]bool{}
+	for _, q := range questions {
+		questionIDs[q.ID.String] = true
+	}
+
+	detail := map[string]string{}
+	questionAnswers := map[string][]survey.AnswerValue{}
+	for _, a := range answers {
+		if !questionIDs[a.QuestionId.String] {
+			detail[a.QuestionId.String] = u.Ctx.Trans("question_not_in_survey", map[string]string{
+				"question_id": a.QuestionId.String,
+			})
+			continue
+		}
+		questionAnswers[a.QuestionId.String] = append(questionAnswers[a.QuestionId.String], survey.AnswerValue{
+			ChoiseID:   a.ChoiseId.String,
+			RowID:      a.RowId.String,
+			Rank:       a.Rank.Int64,
+			AnswerText: a.AnswerText.String,
+		})
+	}
+
+	for _, q := range questions {
+		err := q.ValidateAnswers(u.Ctx, questionAnswers[q.ID.String])
+		if err != nil {
+			detail[q.ID.String] = err.Error()
+		}
+	}
+	if len(detail) > 0 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_answers"), detail)
+	}
+	return nil
+}
+
+// answerValues returns the values of the answers grouped by the question id, used to evaluate the survey logic.
+func answerValues(answers []Answer) map[string][]survey.AnswerValue {
+	values := map[string][]survey.AnswerValue{}
+	for _, a := range answers {
+		values[a.QuestionId.String] = append(values[a.QuestionId.String], survey.AnswerValue{
+			ChoiseID:   a.ChoiseId.String,
+			RowID:      a.RowId.String,
+			Rank:       a.Rank.Int64,
+			AnswerText: a.AnswerText.String,
+		})
+	}
+	return values
+}
+
+// visibleAnswers returns the answers without the answers of the hidden questions.
+func visibleAnswers(answers []Answer, v survey.Visibility) []Answer {
+	res := []Answer{}
+	for _, a := range answers {
+		if !v.IsQuestionHidden(a.QuestionId.String) {
+			res = append(res, a)
+		}
+	}
+	return res
+}
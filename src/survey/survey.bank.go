@@ -0,0 +1,165 @@
+package survey
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/survey-app/survey/app"
+)
+
+// BankQuestion is the reusable question definition of the question bank, the question of the survey refers to it by bank_question_id.
+// The question bank is managed by the bank package, this is the read only model used to create the question from the definition.
+type BankQuestion struct {
+	app.Model
+	ID           app.NullUUID   `json:"id"            db:"bq.id"            gorm:"column:id"`
+	QuestionText app.NullText   `json:"question_text" db:"bq.question_text" gorm:"column:question_text"`
+	Type         app.NullString `json:"type"          db:"bq.type"          gorm:"column:type"`
+	Config       app.NullJSON   `json:"config"        db:"bq.config"        gorm:"column:config"`
+	Validation   app.NullJSON   `json:"validation"    db:"bq.validation"    gorm:"column:validation"`
+	Choices      app.NullJSON   `json:"choices"       db:"bq.choices"       gorm:"column:choices"`
+	Rows         app.NullJSON   `json:"rows"          db:"bq.rows"          gorm:"column:rows"`
+}
+
+// TableVersion returns the versions of the bank_questions table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (BankQuestion) TableVersion() string {
+	return "26.10.181600"
+}
+
+// TableName returns the name of the bank_questions table in the database.
+func (BankQuestion) TableName() string {
+	return "bank_questions"
+}
+
+// TableAliasName returns the table alias name of the bank_questions table, used for querying.
+func (BankQuestion) TableAliasName() string {
+	return "bq"
+}
+
+// GetRelations returns the relations of the bank_questions data in the database, used for querying.
+func (m *BankQuestion) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the bank_questions data in the database, used for querying.
+func (m *BankQuestion) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "bq.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the bank_questions data in the database, used for querying.
+func (m *BankQuestion) GetSorts() []map[string]any {
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the bank_questions data in the database, used for querying.
+func (m *BankQuestion) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the bank_questions schema, used for querying.
+func (m *BankQuestion) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// GetBankQuestion returns the bank question for the specified ID, it returns bad request error when the bank question does not exist.
+func GetBankQuestion(ctx *app.Ctx, id string) (BankQuestion, error) {
+	res := BankQuestion{}
+
+	// prepare db for current ctx
+	tx, err := ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	err = app.First(tx, &res, url.Values{"id": []string{id}})
+	if err != nil {
+		return res, ctx.InvalidReferenceError(err, "bank_questions", "id", id)
+	}
+	return res, nil
+}
+
+// ParseBankChoices returns the choices of the bank question, the id of the choice is ignored since
+// the choice is copied under a fresh id to each question referring to the bank question.
+func ParseBankChoices(choices app.NullJSON) ([]SnapshotChoice, error) {
+	res := []SnapshotChoice{}
+	err := choices.Unmarshal(&res)
+	if err != nil {
+		return res, err
+	}
+	for _, c := range res {
+		if c.ChoiceText == "" {
+			return res, errors.New("choice_text of the choice is required")
+		}
+	}
+	return res, nil
+}
+
+// ParseBankRows returns the rows of the matrix bank question, the id of the row is ignored since
+// the row is copied under a fresh id to each question referring to the bank question.
+func ParseBankRows(rows app.NullJSON) ([]SnapshotRow, error) {
+	res := []SnapshotRow{}
+	err := rows.Unmarshal(&res)
+	if err != nil {
+		return res, err
+	}
+	for _, r := range res {
+		if r.RowText == "" {
+			return res, errors.New("row_text of the row is required")
+		}
+	}
+	return res, nil
+}
+
+// Apply returns the question q with its undefined text, type, config and validation taken from the bank question b,
+// the choices and the rows are not touched, use Choises and QuestionRows to get them.
+func (b BankQuestion) Apply(q Question) Question {
+	q.BankQuestionID = b.ID
+	if !q.QuestionText.Valid {
+		q.QuestionText = b.QuestionText
+	}
+	if !q.Type.Valid {
+		q.Type = b.Type
+	}
+	if !q.Config.Valid {
+		q.Config = b.Config
+	}
+	if !q.Validation.Valid {
+		q.Validation = b.Validation
+	}
+	return q
+}
+
+// Choises returns the choices of the bank question b without ids, the malformed choices are ignored.
+func (b BankQuestion) Choises() []Choise {
+	res := []Choise{}
+	choices, _ := ParseBankChoices(b.Choices)
+	for i, c := range choices {
+		choise := Choise{}
+		choise.ChoiseText.Set(c.ChoiceText)
+		choise.Position.Set(c.Position)
+		if c.Position == 0 {
+			choise.Position.Set(int64(i + 1))
+		}
+		res = append(res, choise)
+	}
+	return res
+}
+
+// QuestionRows returns the rows of the matrix bank question b without ids, the malformed rows are ignored.
+func (b BankQuestion) QuestionRows() []Row {
+	res := []Row{}
+	rows, _ := ParseBankRows(b.Rows)
+	for i, r := range rows {
+		row := Row{}
+		row.RowText.Set(r.RowText)
+		row.Position.Set(r.Position)
+		if r.Position == 0 {
+			row.Position.Set(int64(i + 1))
+		}
+		res = append(res, row)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestBankQuestion(t *testing.T) {
+	b := BankQuestion{}
+	b.ID = app.NewNullUUID()
+	b.QuestionText.Set("How satisfied are you?")
+	b.Type.Set(QuestionTypeSingleChoice)
+	err := json.Unmarshal([]byte(`[{"choice_text":"Satisfied"},{"choice_text":"Unsatisfied","position":5}]`), &b.Choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := Question{}
+	q.QuestionText.Set("How satisfied are you with the event?")
+	q = b.Apply(q)
+	if q.BankQuestionID.String != b.ID.String || q.QuestionText.String != "How satisfied are you with the event?" || q.Type.String != QuestionTypeSingleChoice {
+		t.Errorf("expected the undefined fields taken from the bank question, got %+v", q)
+	}
+
+	choises := b.Choises()
+	if len(choises) != 2 || choises[0].ID.Valid || choises[0].Position.Int64 != 1 || choises[1].Position.Int64 != 5 || choises[1].ChoiseText.String != "Unsatisfied" {
+		t.Errorf("expected the choices of the bank question without ids, got %+v", choises)
+	}
+
+	for structure, isValid := range map[string]bool{
+		`null`:                      true,
+		`[{"choice_text":"Yes"}]`:   true,
+		`[{"position":1}]`:          false,
+		`{"choice_text":"Yes"}`:     false,
+		`[{"choice_text":"Yes"},1]`: false,
+	} {
+		choices := app.NullJSON{}
+		json.Unmarshal([]byte(structure), &choices)
+		_, err = ParseBankChoices(choices)
+		if (err == nil) != isValid {
+			t.Errorf("%s: expected valid %v, got error %v", structure, isValid, err)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package survey
+
+import (
+	"encoding/json"
+
+	"github.com/survey-app/survey/app"
+)
+
+// Clone returns the deep copy of the survey s along with its sections, questions, choices and rows under fresh ids.
+// The logic (visible_if and branches) of the copy refers to the copied sections, questions and choices.
+// The copy always starts as draft without schedule, so it is not published automatically by the schedule of the original survey.
+func (s Survey) Clone() Survey {
+	res := Survey{}
+	res.ID = app.NewNullUUID()
+	res.Title = s.Title
+	res.Description = s.Description
+	res.Status.Set(StatusDraft)
+	res.IsActive.Set(false)
+	res.MaxResponses = s.MaxResponses
+
+	// the new ids of the sections, questions, choices and rows, keyed by the old ids
+	ids := map[string]string{}
+	for _, sec := range s.Sections {
+		ids[sec.ID.String] = app.NewNullUUID().String
+	}
+	for _, q := range s.Questions {
+		ids[q.ID.String] = app.NewNullUUID().String
+		for _, c := range q.Choises {
+			ids[c.ID.String] = app.NewNullUUID().String
+		}
+		for _, r := range q.Rows {
+			ids[r.ID.String] = app.NewNullUUID().String
+		}
+	}
+
+	res.Sections, res.Questions = s.replaceIDs(res.ID, ids)
+	res.Translations = cloneTranslations(s.Translations, ids)
+	return res
+}
+
+// replaceIDs returns the sections and the questions of the survey s along with their choices and rows under the survey id,
+// the ids are replaced by the new ids keyed by the old ids, the id missing from the new ids is kept as is.
+// The logic (visible_if and branches) refers to the new ids too.
+func (s Survey) replaceIDs(surveyID app.NullUUID, ids map[string]string) ([]Section, []Question) {
+	replace := func(id app.NullUUID) app.NullUUID {
+		if newID, ok := ids[id.String]; ok {
+			id.Set(newID)
+		}
+		return id
+	}
+	sectionIDs := map[string]app.NullUUID{}
+	for _, sec := range s.Sections {
+		for _, q := range sec.Questions {
+			sectionIDs[q.ID.String] = replace(sec.ID)
+		}
+	}
+
+	questions := map[string]Question{}
+	resQuestions := []Question{}
+	for _, q := range s.Questions {
+		question := q
+		question.ID = replace(q.ID)
+		question.SurveyID = surveyID
+		question.SectionID = sectionIDs[q.ID.String]
+		question.VisibleIf = cloneCondition(q.VisibleIf, ids)
+		question.Choises = []Choise{}
+		for _, c := range q.Choises {
+			choise := c
+			choise.ID = replace(c.ID)
+			choise.QuestionID = question.ID
+			question.Choises = append(question.Choises, choise)
+		}
+		question.Rows = []Row{}
+		for _, r := range q.Rows {
+			row := r
+			row.ID = replace(r.ID)
+			row.QuestionID = question.ID
+			question.Rows = append(question.Rows, row)
+		}
+		resQuestions = append(resQuestions, question)
+		questions[q.ID.String] = question
+	}
+
+	resSections := []Section{}
+	for _, sec := range s.Sections {
+		section := sec
+		section.ID = replace(sec.ID)
+		section.SurveyID = surveyID
+		section.VisibleIf = cloneCondition(sec.VisibleIf, ids)
+		section.Branches = cloneBranches(sec.Branches, ids)
+		section.Questions = []Question{}
+		for _, q := range sec.Questions {
+			section.Questions = append(section.Questions, questions[q.ID.String])
+		}
+		resSections = append(resSections, section)
+	}
+	return resSections, resQuestions
+}
+
+// cloneCondition returns the visible_if condition with the question ids and the choice ids replaced by the new ids,
+// the malformed condition is copied as is.
+func cloneCondition(visibleIf app.NullJSON, ids map[string]string) app.NullJSON {
+	c, err := ParseCondition(visibleIf)
+	if err != nil || c == nil {
+		return visibleIf
+	}
+	return toNullJSON(c.replaceIDs(ids), visibleIf)
+}
+
+// cloneBranches returns the branches with the section ids, the question ids and the choice ids replaced by the new ids,
+// the malformed branches are copied as is.
+func cloneBranches(branches app.NullJSON, ids map[string]string) app.NullJSON {
+	b, err := ParseBranches(branches)
+	if err != nil || !branches.Valid {
+		return branches
+	}
+	for i := range b {
+		b[i].Condition = b[i].Condition.replaceIDs(ids)
+		if id, ok := ids[b[i].GoTo]; ok {
+			b[i].GoTo = id
+		}
+	}
+	return toNullJSON(b, branches)
+}
+
+// replaceIDs returns the copy of the condition c with the question id and the value replaced by the new ids,
+// the value is only replaced when it is the id of a choice.
+func (c Condition) replaceIDs(ids map[string]string) Condition {
+	if id, ok := ids[c.QuestionID]; ok {
+		c.QuestionID = id
+	}
+	if id, ok := ids[c.Value]; ok {
+		c.Value = id
+	}
+	conditions := []Condition{}
+	for _, nested := range c.Conditions {
+		conditions = append(conditions, nested.replaceIDs(ids))
+	}
+	if len(conditions) > 0 {
+		c.Conditions = conditions
+	}
+	return c
+}
+
+// toNullJSON returns v as NullJSON, it returns the fallback when v can not be encoded.
+func toNullJSON(v any, fallback app.NullJSON) app.NullJSON {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fallback
+	}
+	res := app.NullJSON{}
+	err = json.Unmarshal(b, &res)
+	if err != nil {
+		return fallback
+	}
+	return res
+}
@@ -0,0 +1,73 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestSurveyClone(t *testing.T) {
+	nullJSON := func(s string) app.NullJSON {
+		n := app.NullJSON{}
+		err := json.Unmarshal([]byte(s), &n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	q1, q2 := Question{}, Question{}
+	q1.ID.Set("q1")
+	q1.Code.Set("q_rating")
+	c1 := Choise{}
+	c1.ID.Set("c1")
+	c1.ChoiseText.Set("No")
+	q1.Choises = []Choise{c1}
+	r1 := Row{}
+	r1.ID.Set("r1")
+	r1.RowText.Set("Speed")
+	q1.Rows = []Row{r1}
+	q2.ID.Set("q2")
+	q2.VisibleIf = nullJSON(`{"operator":"equals","question_id":"q1","value":"c1"}`)
+	s1, s2 := Section{Questions: []Question{q1}}, Section{Questions: []Question{q2}}
+	s1.ID.Set("s1")
+	s1.Branches = nullJSON(`[{"condition":{"operator":"equals","question_id":"q1","value":"No"},"go_to":"s2"}]`)
+	s2.ID.Set("s2")
+	s := Survey{Sections: []Section{s1, s2}, Questions: []Question{q1, q2}}
+	s.ID.Set("survey")
+	s.Title.Set("Quarterly Review")
+	s.Status.Set(StatusClosed)
+	s.OpensAt.Set(time.Now())
+
+	c := s.Clone()
+	if c.ID.String == s.ID.String || c.CurrentStatus() != StatusDraft || c.OpensAt.Valid || c.Title.String != s.Title.String {
+		t.Fatalf("expected a draft copy without schedule under a fresh id, got %+v", c)
+	}
+	newQ1, newQ2, newC1, newR1 := c.Questions[0], c.Questions[1], c.Questions[0].Choises[0], c.Questions[0].Rows[0]
+	newS1, newS2 := c.Sections[0], c.Sections[1]
+	for _, id := range []string{newQ1.ID.String, newQ2.ID.String, newC1.ID.String, newR1.ID.String, newS1.ID.String, newS2.ID.String} {
+		if !app.Validator().IsValid(id, "uuid") {
+			t.Errorf("expected fresh uuid, got [%s]", id)
+		}
+	}
+	if newQ1.Code.String != "q_rating" || newQ1.SurveyID.String != c.ID.String || newQ1.SectionID.String != newS1.ID.String {
+		t.Errorf("expected q1 copied into the copied section, got %+v", newQ1)
+	}
+	if newC1.QuestionID.String != newQ1.ID.String {
+		t.Errorf("expected c1 copied into the copied q1, got %+v", newC1)
+	}
+	if newR1.QuestionID.String != newQ1.ID.String || newR1.RowText.String != "Speed" {
+		t.Errorf("expected r1 copied into the copied q1, got %+v", newR1)
+	}
+
+	condition, _ := ParseCondition(newQ2.VisibleIf)
+	if condition == nil || condition.QuestionID != newQ1.ID.String || condition.Value != newC1.ID.String {
+		t.Errorf("expected the visible_if refer to the copied q1 and c1, got %+v", condition)
+	}
+	branches, _ := ParseBranches(newS1.Branches)
+	if len(branches) != 1 || branches[0].GoTo != newS2.ID.String || branches[0].Condition.QuestionID != newQ1.ID.String || branches[0].Condition.Value != "No" {
+		t.Errorf("expected the branches refer to the copied s2 and q1, got %+v", branches)
+	}
+}
@@ -0,0 +1,246 @@
+package survey
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+// DefinitionSchemaVersion is the schema version of the Definition written by this version of the app,
+// increase it when the Definition changes in a way the older documents can not be read anymore.
+const DefinitionSchemaVersion = 1
+
+// Definition is the portable document of the complete survey definition, used to keep the survey definition under
+// version control and to promote it between environments. The document is canonical, the sections, questions, choices
+// and rows are ordered by position and the logic (visible_if and branches) refers to the ids of the document.
+type Definition struct {
+	SchemaVersion int64              `json:"schema_version"`
+	Survey        DefinitionSurvey   `json:"survey"`
+	Settings      DefinitionSettings `json:"settings"`
+	Sections      []SnapshotSection  `json:"sections"`
+	Questions     []SnapshotQuestion `json:"questions"`
+	Translations  Translations       `json:"translations"`
+}
+
+// DefinitionSurvey is the survey of the Definition.
+type DefinitionSurvey struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// DefinitionSettings is the schedule and the response limit of the survey of the Definition,
+// the lifecycle status is not part of the definition since it is changed by the lifecycle actions only.
+type DefinitionSettings struct {
+	OpensAt      *time.Time `json:"opens_at"`
+	ClosesAt     *time.Time `json:"closes_at"`
+	MaxResponses *int64     `json:"max_responses"`
+}
+
+// Translations are the translated texts of the survey keyed by the locale, then by the id of the section, question, choice
+// or row ("survey" for the survey itself), then by the translated field, e.g. {"id-ID": {"<question id>": {"question_text": "..."}}}.
+type Translations map[string]map[string]map[string]string
+
+// TranslationKeySurvey is the key of the survey itself on the Translations.
+const TranslationKeySurvey = "survey"
+
+// translatableFields are the fields of the survey, section, question, choice and row that can be translated.
+var translatableFields = map[string]bool{
+	"title":         true,
+	"description":   true,
+	"question_text": true,
+	"choice_text":   true,
+	"row_text":      true,
+}
+
+// ParseTranslations returns the Translations of the survey, it returns empty Translations when the translations are null.
+func ParseTranslations(translations app.NullJSON) (Translations, error) {
+	res := Translations{}
+	if !translations.Valid {
+		return res, nil
+	}
+	err := translations.Unmarshal(&res)
+	if err != nil {
+		return Translations{}, errors.New("translations must be an object keyed by the locale, then by the id, then by the field")
+	}
+	err = res.validate(nil)
+	if err != nil {
+		return Translations{}, err
+	}
+	return res, nil
+}
+
+// ValidateTranslations returns bad request error when the translations of the survey are malformed.
+func ValidateTranslations(ctx *app.Ctx, translations app.NullJSON) error {
+	_, err := ParseTranslations(translations)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_translations", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// validate returns error when the locale is empty or the field can not be translated,
+// or the key is not one of the ids when the ids are defined.
+func (t Translations) validate(ids map[string]bool) error {
+	for locale, texts := range t {
+		if locale == "" {
+			return errors.New("the locale of the translations is required")
+		}
+		for key, fields := range texts {
+			if ids != nil && !ids[key] {
+				return errors.New("the translations of " + strconv.Quote(locale) + " refer to unknown id " + strconv.Quote(key))
+			}
+			for field := range fields {
+				if !translatableFields[field] {
+					return errors.New("the field " + strconv.Quote(field) + " of " + strconv.Quote(key) + " can not be translated")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// cloneTranslations returns the translations with the ids replaced by the new ids, the malformed translations are copied as is.
+func cloneTranslations(translations app.NullJSON, ids map[string]string) app.NullJSON {
+	t, err := ParseTranslations(translations)
+	if err != nil || !translations.Valid {
+		return translations
+	}
+	res := Translations{}
+	for locale, texts := range t {
+		res[locale] = map[string]map[string]string{}
+		for key, fields := range texts {
+			if id, ok := ids[key]; ok {
+				key = id
+			}
+			res[locale][key] = fields
+		}
+	}
+	return toNullJSON(res, translations)
+}
+
+// Definition returns the Definition of the survey s, the malformed translations are left out.
+func (s Survey) Definition() Definition {
+	sn := s.Snapshot()
+	res := Definition{
+		SchemaVersion: DefinitionSchemaVersion,
+		Survey:        DefinitionSurvey{Title: sn.Title, Description: sn.Description},
+		Sections:      sn.Sections,
+		Questions:     sn.Questions,
+	}
+	if s.OpensAt.Valid {
+		t := s.OpensAt.Time.UTC()
+		res.Settings.OpensAt = &t
+	}
+	if s.ClosesAt.Valid {
+		t := s.ClosesAt.Time.UTC()
+		res.Settings.ClosesAt = &t
+	}
+	if s.MaxResponses.Valid {
+		n := s.MaxResponses.Int64
+		res.Settings.MaxResponses = &n
+	}
+	res.Translations, _ = ParseTranslations(s.Translations)
+
+	if res.Sections == nil {
+		res.Sections = []SnapshotSection{}
+	}
+	sort.SliceStable(res.Sections, func(i, j int) bool {
+		return res.Sections[i].Position < res.Sections[j].Position
+	})
+	sort.SliceStable(res.Questions, func(i, j int) bool {
+		return res.Questions[i].Position < res.Questions[j].Position
+	})
+	for _, q := range res.Questions {
+		sort.SliceStable(q.Choices, func(i, j int) bool {
+			return q.Choices[i].Position < q.Choices[j].Position
+		})
+		sort.SliceStable(q.Rows, func(i, j int) bool {
+			return q.Rows[i].Position < q.Rows[j].Position
+		})
+	}
+	return res
+}
+
+// validate returns error when the schema version is not supported, the structure is malformed,
+// or the translations refer to an unknown id.
+func (d Definition) validate() error {
+	if d.SchemaVersion != DefinitionSchemaVersion {
+		return errors.New("unsupported schema_version " + strconv.FormatInt(d.SchemaVersion, 10) +
+			", expected " + strconv.Itoa(DefinitionSchemaVersion))
+	}
+	if d.Survey.Title == "" {
+		return errors.New("the title of the survey is required")
+	}
+	err := d.snapshot().validate()
+	if err != nil {
+		return err
+	}
+
+	// the ids of the choices and the rows are unique on the whole document, the same as the sections and the questions
+	s := d.snapshot().Survey()
+	ids := map[string]bool{TranslationKeySurvey: true}
+	for _, sec := range s.Sections {
+		ids[sec.ID.String] = true
+	}
+	for _, q := range s.Questions {
+		ids[q.ID.String] = true
+	}
+	for _, q := range s.Questions {
+		for _, c := range q.Choises {
+			if ids[c.ID.String] {
+				return errors.New("duplicated id " + strconv.Quote(c.ID.String))
+			}
+			ids[c.ID.String] = true
+		}
+		for _, r := range q.Rows {
+			if ids[r.ID.String] {
+				return errors.New("duplicated id " + strconv.Quote(r.ID.String))
+			}
+			ids[r.ID.String] = true
+		}
+	}
+
+	return d.Translations.validate(ids)
+}
+
+// snapshot returns the structure of the Definition as Snapshot.
+func (d Definition) snapshot() Snapshot {
+	return Snapshot{Title: d.Survey.Title, Description: d.Survey.Description, Sections: d.Sections, Questions: d.Questions}
+}
+
+// ToSurvey returns the survey of the Definition d along with its settings, translations, sections, questions, choices and rows
+// under the ids of the document. The question without choices or rows has empty choices or rows, so the existing choices
+// and rows are removed when the Definition is applied.
+func (d Definition) ToSurvey() Survey {
+	s := d.snapshot().Survey()
+	if d.Settings.OpensAt != nil {
+		s.OpensAt.Set(d.Settings.OpensAt.UTC())
+	}
+	if d.Settings.ClosesAt != nil {
+		s.ClosesAt.Set(d.Settings.ClosesAt.UTC())
+	}
+	if d.Settings.MaxResponses != nil {
+		s.MaxResponses.Set(*d.Settings.MaxResponses)
+	}
+	if len(d.Translations) > 0 {
+		s.Translations = toNullJSON(d.Translations, app.NullJSON{})
+	}
+
+	s.Sections, s.Questions = s.replaceIDs(s.ID, map[string]string{})
+	return s
+}
+
+// Equal reports whether the Definition d is the same document as the other Definition.
+func (d Definition) Equal(other Definition) bool {
+	a, errA := json.Marshal(d)
+	b, errB := json.Marshal(other)
+	return errA == nil && errB == nil && bytes.Equal(a, b)
+}
@@ -0,0 +1,158 @@
+package survey
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestSurveyDefinition(t *testing.T) {
+	nullJSON := func(s string) app.NullJSON {
+		n := app.NullJSON{}
+		err := json.Unmarshal([]byte(s), &n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	q1, q2, q3 := Question{}, Question{}, Question{}
+	q1.ID.Set("q1")
+	q1.Code.Set("q_color")
+	q1.QuestionText.Set("Favorite color")
+	q1.Type.Set(QuestionTypeSingleChoice)
+	q1.Position.Set(1)
+	c1, c2 := Choise{}, Choise{}
+	c1.ID.Set("c1")
+	c1.ChoiseText.Set("Red")
+	c1.Position.Set(2)
+	c2.ID.Set("c2")
+	c2.ChoiseText.Set("Blue")
+	c2.Position.Set(1)
+	q1.Choises = []Choise{c1, c2}
+	q2.ID.Set("q2")
+	q2.QuestionText.Set("Why red?")
+	q2.Type.Set(QuestionTypeShortText)
+	q2.Position.Set(2)
+	q2.VisibleIf = nullJSON(`{"operator":"equals","question_id":"q1","value":"c1"}`)
+	q3.ID.Set("q3")
+	q3.QuestionText.Set("Anything else?")
+	q3.Type.Set(QuestionTypeShortText)
+	q3.Position.Set(3)
+	s1, s2 := Section{Questions: []Question{q1}}, Section{Questions: []Question{q2}}
+	s1.ID.Set("s1")
+	s1.Title.Set("Colors")
+	s1.Position.Set(1)
+	s1.Branches = nullJSON(`[{"condition":{"operator":"equals","question_id":"q1","value":"c2"},"go_to":"s2"}]`)
+	s2.ID.Set("s2")
+	s2.Position.Set(2)
+	s := Survey{Sections: []Section{s1, s2}, Questions: []Question{q1, q2, q3}}
+	s.ID.Set("survey")
+	s.Title.Set("Colors")
+	s.OpensAt.Set(time.Date(2026, 11, 1, 8, 0, 0, 0, time.UTC))
+	s.MaxResponses.Set(100)
+	s.Translations = nullJSON(`{"id-ID":{"survey":{"title":"Warna"},"c1":{"choice_text":"Merah"}}}`)
+
+	d := s.Definition()
+	if d.SchemaVersion != DefinitionSchemaVersion || d.Survey.Title != "Colors" || *d.Settings.MaxResponses != 100 || d.Settings.ClosesAt != nil {
+		t.Fatalf("expected the definition of the survey with its settings, got %+v", d)
+	}
+	if d.Questions[0].Choices[0].ID != "c2" || d.Questions[1].SectionID != "s2" || d.Questions[2].SectionID != "" {
+		t.Errorf("expected the choices ordered by position and the questions placed on their sections, got %+v", d.Questions)
+	}
+	if d.Translations["id-ID"]["c1"]["choice_text"] != "Merah" {
+		t.Errorf("expected the translations on the definition, got %+v", d.Translations)
+	}
+
+	// the document read back and converted to the survey again is the same document
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := Definition{}
+	err = json.Unmarshal(b, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = parsed.validate()
+	if err != nil {
+		t.Fatalf("expected valid definition, got %v", err)
+	}
+	roundTrip := parsed.ToSurvey()
+	if !d.Equal(roundTrip.Definition()) {
+		actual, _ := json.Marshal(roundTrip.Definition())
+		t.Errorf("expected the same definition after the round trip, got\n%s\nexpected\n%s", actual, b)
+	}
+	if len(roundTrip.Questions[2].Choises) != 0 || roundTrip.Questions[2].Choises == nil {
+		t.Errorf("expected the empty choices of the question without choices, got %+v", roundTrip.Questions[2].Choises)
+	}
+
+	// the ids of the definition are replaced along with the logic and the translations
+	ids := map[string]string{"q1": "new-q1", "c1": "new-c1", "s2": "new-s2"}
+	sections, questions := roundTrip.replaceIDs(roundTrip.ID, ids)
+	condition, _ := ParseCondition(questions[1].VisibleIf)
+	branches, _ := ParseBranches(sections[0].Branches)
+	if questions[0].ID.String != "new-q1" || questions[1].SectionID.String != "new-s2" || condition.QuestionID != "new-q1" || condition.Value != "new-c1" ||
+		branches[0].GoTo != "new-s2" || sections[0].Questions[0].ID.String != "new-q1" {
+		t.Errorf("expected the logic refer to the new ids, got %+v %+v", condition, branches)
+	}
+	translations, _ := ParseTranslations(cloneTranslations(roundTrip.Translations, ids))
+	if translations["id-ID"]["new-c1"]["choice_text"] != "Merah" || translations["id-ID"]["survey"]["title"] != "Warna" {
+		t.Errorf("expected the translations refer to the new ids, got %+v", translations)
+	}
+
+	// the definition of the survey applied to another survey matches the rows of that survey by their stable keys,
+	// so the other survey keeps its ids when the same definition is applied again
+	other := s.Clone()
+	matched := matchIDs(other, roundTrip)
+	for _, id := range []string{"s1", "s2", "q1", "q2", "q3", "c1", "c2"} {
+		if matched[id] == "" || matched[id] == id {
+			t.Errorf("expected %s matched to the row of the other survey, got %v", id, matched)
+		}
+	}
+	sections, questions = roundTrip.replaceIDs(other.ID, matched)
+	applied := Survey{Sections: sections, Questions: questions}.Definition()
+	expected := Survey{Sections: other.Sections, Questions: other.Questions}.Definition()
+	if !applied.Equal(expected) {
+		actual, _ := json.Marshal(applied)
+		b, _ := json.Marshal(expected)
+		t.Errorf("expected the definition applied under the ids of the other survey, got\n%s\nexpected\n%s", actual, b)
+	}
+	q4, q5 := Question{}, Question{}
+	q4.ID.Set("q4")
+	q4.Code.Set("q_new")
+	q4.Position.Set(4)
+	q5.ID.Set("q5")
+	q5.Code.Set("q_other")
+	q5.Position.Set(1)
+	matched = matchIDs(other, Survey{Questions: []Question{q4, q5}})
+	if len(matched) != 0 {
+		t.Errorf("expected the new question and the question of another code at the same position unmatched, got %v", matched)
+	}
+
+	invalids := []struct {
+		document string
+		expected string
+	}{
+		{`{"survey":{"title":"Colors"},"questions":[{"question_text":"Name","type":"short_text"}]}`, "schema_version"},
+		{`{"schema_version":1,"survey":{},"questions":[{"question_text":"Name","type":"short_text"}]}`, "title"},
+		{`{"schema_version":1,"survey":{"title":"Colors"},"questions":[]}`, "at least one question"},
+		{`{"schema_version":1,"survey":{"title":"Colors"},"questions":[{"id":"q1","choices":[{"id":"c1"}]},{"id":"q2","choices":[{"id":"c1"}]}]}`, "duplicated id"},
+		{`{"schema_version":1,"survey":{"title":"Colors"},"questions":[{"id":"q1","type":"short_text"}],"translations":{"id-ID":{"q9":{"question_text":"Nama"}}}}`, "unknown id"},
+		{`{"schema_version":1,"survey":{"title":"Colors"},"questions":[{"id":"q1","type":"short_text"}],"translations":{"id-ID":{"q1":{"type":"teks"}}}}`, "can not be translated"},
+	}
+	for _, invalid := range invalids {
+		d := Definition{}
+		err := json.Unmarshal([]byte(invalid.document), &d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = d.validate()
+		if err == nil || !strings.Contains(err.Error(), invalid.expected) {
+			t.Errorf("expected error containing [%s] of %s, got %v", invalid.expected, invalid.document, err)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package survey
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/survey-app/survey/app"
+)
+
+// The supported operators of the logic condition, "and" and "or" combine the nested conditions,
+// the others compare the answers of the question with the value.
+const (
+	LogicAnd                = "and"
+	LogicOr                 = "or"
+	LogicEquals             = "equals"
+	LogicNotEquals          = "not_equals"
+	LogicIncludes           = "includes"
+	LogicNotIncludes        = "not_includes"
+	LogicGreaterThan        = "greater_than"
+	LogicGreaterThanOrEqual = "greater_than_or_equal"
+	LogicLessThan           = "less_than"
+	LogicLessThanOrEqual    = "less_than_or_equal"
+	LogicAnswered           = "answered"
+	LogicNotAnswered        = "not_answered"
+)
+
+// BranchEnd is the go to target of the branch which ends the survey.
+const BranchEnd = "end"
+
+// Condition is the logic condition over the earlier answers, stored on the visible_if column of the questions
+// and sections table and on the branches column of the sections table.
+//
+// For example, show the question only when Q2 includes "Other":
+//
+//	{"operator": "includes", "question_id": "<Q2 id>", "value": "Other"}
+//
+// The value is compared with the answer text, or with the id or the text of the selected choices.
+type Condition struct {
+	Operator   string      `json:"operator"`
+	Conditions []Condition `json:"conditions,omitempty"` // and, or
+	QuestionID string      `json:"question_id,omitempty"`
+	Value      string      `json:"value,omitempty"`
+}
+
+// Branch jumps to the section GoTo when the condition is true after the section is answered, the sections in between are skipped.
+// The GoTo must be the id of a later section or "end", it is validated by ValidateBranchTargets when the sections are saved.
+//
+// For example, skip to section 5 when Q3 is "No":
+//
+//	[{"condition": {"operator": "equals", "question_id": "<Q3 id>", "value": "No"}, "go_to": "<section 5 id>"}]
+type Branch struct {
+	Condition Condition `json:"condition"`
+	GoTo      string    `json:"go_to"`
+}
+
+// ParseCondition returns the Condition of the visible_if column, it returns nil when the condition is undefined.
+func ParseCondition(visibleIf app.NullJSON) (*Condition, error) {
+	var c *Condition
+	err := visibleIf.Unmarshal(&c)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return c, c.validate()
+}
+
+// ParseBranches returns the Branches of the branches column.
+func ParseBranches(branches app.NullJSON) ([]Branch, error) {
+	b := []Branch{}
+	err := branches.Unmarshal(&b)
+	if err != nil {
+		return b, err
+	}
+	for _, branch := range b {
+		if branch.GoTo == "" {
+			return b, errors.New("go_to is required")
+		}
+		err = branch.Condition.validate()
+		if err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+// ValidateCondition returns bad request error when the visible_if condition is malformed.
+func ValidateCondition(ctx *app.Ctx, visibleIf app.NullJSON) error {
+	_, err := ParseCondition(visibleIf)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_logic", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// ValidateBranches returns bad request error when the branches are malformed.
+func ValidateBranches(ctx *app.Ctx, branches app.NullJSON) error {
+	_, err := ParseBranches(branches)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_logic", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// ValidateBranchTargets returns bad request error when the go_to of a branch of the sections is neither "end"
+// nor the id of a later section, the sections are ordered by position.
+func ValidateBranchTargets(ctx *app.Ctx, sections []Section) error {
+	err := validateBranchTargets(sections)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_logic", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// validateBranchTargets returns error when the go_to of a branch of the sections is neither "end" nor the id of a later section.
+func validateBranchTargets(sections []Section) error {
+	sorted := append([]Section{}, sections...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position.Int64 < sorted[j].Position.Int64
+	})
+	for i, sec := range sorted {
+		branches, err := ParseBranches(sec.Branches)
+		if err != nil {
+			return err
+		}
+		for _, b := range branches {
+			isLater := b.GoTo == BranchEnd
+			for _, later := range sorted[i+1:] {
+				if later.ID.String == b.GoTo {
+					isLater = true
+				}
+			}
+			if !isLater {
+				return errors.New("go_to " + strconv.Quote(b.GoTo) + " of the section " + strconv.Quote(sec.ID.String) +
+					" must be " + strconv.Quote(BranchEnd) + " or the id of a later section")
+			}
+		}
+	}
+	return nil
+}
+
+// validate returns error when the operator is unsupported or the operand is missing.
+func (c Condition) validate() error {
+	switch c.Operator {
+	case LogicAnd, LogicOr:
+		if len(c.Conditions) == 0 {
+			return errors.New(c.Operator + " requires at least one condition")
+		}
+		for _, nested := range c.Conditions {
+			err := nested.validate()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case LogicAnswered, LogicNotAnswered:
+	case LogicEquals, LogicNotEquals, LogicIncludes, LogicNotIncludes,
+		LogicGreaterThan, LogicGreaterThanOrEqual, LogicLessThan, LogicLessThanOrEqual:
+		if c.Value == "" {
+			return errors.New(c.Operator + " requires value")
+		}
+	default:
+		return errors.New("unsupported operator " + strconv.Quote(c.Operator))
+	}
+	if c.QuestionID == "" {
+		return errors.New(c.Operator + " requires question_id")
+	}
+	return nil
+}
+
+// Visibility is the result of the survey logic evaluation, the key is the id of the section or the question.
+type Visibility struct {
+	Sections  map[string]bool
+	Questions map[string]bool
+}
+
+// IsQuestionHidden reports whether the question is hidden by the logic, the unknown question is not hidden.
+func (v Visibility) IsQuestionHidden(id string) bool {
+	isVisible, ok := v.Questions[id]
+	return ok && !isVisible
+}
+
+// VisibleQuestions returns the visible questions of the questions.
+func (v Visibility) VisibleQuestions(questions []Question) []Question {
+	res := []Question{}
+	for _, q := range questions {
+		if !v.IsQuestionHidden(q.ID.String) {
+			res = append(res, q)
+		}
+	}
+	return res
+}
+
+// HiddenQuestionIDs returns the ids of the hidden questions.
+func (v Visibility) HiddenQuestionIDs() []string {
+	ids := []string{}
+	for id, isVisible := range v.Questions {
+		if !isVisible {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Evaluate evaluates the logic of the survey s against the answers, the key of the answers is the question id.
+// The sections and the questions are evaluated in order, so the conditions only see the answers of the earlier visible questions,
+// the answers of the hidden questions are ignored. The questions outside of the sections are evaluated last.
+func (s Survey) Evaluate(answers map[string][]AnswerValue) Visibility {
+	v := Visibility{Sections: map[string]bool{}, Questions: map[string]bool{}}
+	l := logic{questions: map[string]Question{}, answers: map[string][]AnswerValue{}}
+	for _, q := range s.Questions {
+		l.questions[q.ID.String] = q
+	}
+
+	skipTo := ""
+	isSectioned := map[string]bool{}
+	for _, sec := range s.Sections {
+		isVisible := skipTo == "" || skipTo == sec.ID.String
+		if isVisible {
+			skipTo = ""
+			isVisible = l.isVisible(sec.VisibleIf)
+		}
+		v.Sections[sec.ID.String] = isVisible
+
+		for _, q := range sec.Questions {
+			isSectioned[q.ID.String] = true
+			l.questions[q.ID.String] = q
+			l.visit(q, isVisible, answers, v)
+		}
+
+		if isVisible {
+			branches, _ := ParseBranches(sec.Branches) // already validated when saved
+			for _, b := range branches {
+				if l.evaluate(b.Condition) {
+					skipTo = b.GoTo
+					break
+				}
+			}
+		}
+	}
+
+	for _, q := range s.Questions {
+		if !isSectioned[q.ID.String] {
+			l.visit(q, true, answers, v)
+		}
+	}
+	return v
+}
+
+// FirstSection returns the first visible section, it returns false when the survey has no visible section.
+func (s Survey) FirstSection(v Visibility) (Section, bool) {
+	for _, sec := range s.Sections {
+		if v.Sections[sec.ID.String] {
+			return sec, true
+		}
+	}
+	return Section{}, false
+}
+
+// NextSection returns the next visible section after the section with the specified id, the sections are already sorted by position.
+// It returns false when there is no more visible section, which means the section is the last page of the survey.
+func (s Survey) NextSection(id string, v Visibility) (Section, bool) {
+	isAfter := false
+	for _, sec := range s.Sections {
+		if isAfter && v.Sections[sec.ID.String] {
+			return sec, true
+		}
+		if sec.ID.String == id {
+			isAfter = true
+		}
+	}
+	return Section{}, false
+}
+
+// logic holds the state of the survey logic evaluation.
+type logic struct {
+	questions map[string]Question
+	answers   map[string][]AnswerValue // the answers of the visible questions evaluated so far
+}
+
+// visit evaluates the visibility of the question q, the answers of q are visible for the next conditions when q is visible.
+func (l logic) visit(q Question, isParentVisible bool, answers map[string][]AnswerValue, v Visibility) {
+	isVisible := isParentVisible && l.isVisible(q.VisibleIf)
+	v.Questions[q.ID.String] = isVisible
+	if isVisible && len(answers[q.ID.String]) > 0 {
+		l.answers[q.ID.String] = answers[q.ID.String]
+	}
+}
+
+// isVisible evaluates the visible_if condition, it is visible when the condition is undefined.
+func (l logic) isVisible(visibleIf app.NullJSON) bool {
+	c, err := ParseCondition(visibleIf)
+	if err != nil || c == nil {
+		return true // already validated when saved
+	}
+	return l.evaluate(*c)
+}
+
+// evaluate returns the result of the condition c against the visible answers.
+func (l logic) evaluate(c Condition) bool {
+	switch c.Operator {
+	case LogicAnd:
+		for _, nested := range c.Conditions {
+			if !l.evaluate(nested) {
+				return false
+			}
+		}
+		return true
+	case LogicOr:
+		for _, nested := range c.Conditions {
+			if l.evaluate(nested) {
+				return true
+			}
+		}
+		return false
+	case LogicAnswered:
+		return len(l.answers[c.QuestionID]) > 0
+	case LogicNotAnswered:
+		return len(l.answers[c.QuestionID]) == 0
+	case LogicEquals:
+		return l.match(c, isEqual)
+	case LogicNotEquals:
+		return !l.match(c, isEqual)
+	case LogicIncludes:
+		return l.match(c, isIncluded)
+	case LogicNotIncludes:
+		return !l.match(c, isIncluded)
+	case LogicGreaterThan:
+		return l.match(c, func(a, b string) bool { return compare(a, b) > 0 })
+	case LogicGreaterThanOrEqual:
+		return l.match(c, func(a, b string) bool { return compare(a, b) >= 0 })
+	case LogicLessThan:
+		return l.match(c, func(a, b string) bool { return compare(a, b) < 0 })
+	case LogicLessThanOrEqual:
+		return l.match(c, func(a, b string) bool { return compare(a, b) <= 0 })
+	}
+	return false
+}
+
+// match reports whether any answer of the question of c matches the value of c.
+func (l logic) match(c Condition, fn func(answer, value string) bool) bool {
+	q := l.questions[c.QuestionID]
+	for _, a := range l.answers[c.QuestionID] {
+		if a.ChoiseID == "" {
+			if fn(strings.TrimSpace(a.AnswerText), c.Value) {
+				return true
+			}
+			continue
+		}
+		if fn(a.ChoiseID, c.Value) {
+			return true
+		}
+		for _, choise := range q.Choises {
+			if choise.ID.String == a.ChoiseID && fn(strings.TrimSpace(choise.ChoiseText.String), c.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isEqual reports whether a and b are equal, case insensitive.
+func isEqual(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// isIncluded reports whether a contains b, case insensitive.
+func isIncluded(a, b string) bool {
+	return strings.Contains(strings.ToLower(a), strings.ToLower(b))
+}
+
+// compare compares a and b as number when both of them are number, otherwise as string (the date format is comparable as string).
+func compare(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
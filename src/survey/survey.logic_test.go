@@ -0,0 +1,195 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestSurveyEvaluate(t *testing.T) {
+	nullJSON := func(s string) app.NullJSON {
+		n := app.NullJSON{}
+		err := json.Unmarshal([]byte(s), &n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	question := func(id string, choises ...string) Question {
+		q := Question{}
+		q.ID.Set(id)
+		for _, c := range choises {
+			choise := Choise{}
+			choise.ID.Set(id + "." + c)
+			choise.ChoiseText.Set(c)
+			q.Choises = append(q.Choises, choise)
+		}
+		return q
+	}
+
+	// s1: q1 (Yes/No), q2 (Other/Price) only when q1 is "Yes"
+	// s2: q3, skipped when q1 is "No"
+	// s3: q4, only when q2 includes "other" and q1 is answered
+	q1, q2, q3, q4 := question("q1", "Yes", "No"), question("q2", "Other", "Price"), question("q3"), question("q4")
+	q2.VisibleIf = nullJSON(`{"operator":"equals","question_id":"q1","value":"yes"}`)
+	q4.VisibleIf = nullJSON(`{"operator":"and","conditions":[
+		{"operator":"includes","question_id":"q2","value":"other"},
+		{"operator":"answered","question_id":"q1"}
+	]}`)
+	s := Survey{}
+	for i, questions := range [][]Question{{q1, q2}, {q3}, {q4}} {
+		sec := Section{}
+		sec.ID.Set("s" + string(rune('1'+i)))
+		sec.Questions = questions
+		s.Sections = append(s.Sections, sec)
+		s.Questions = append(s.Questions, questions...)
+	}
+	s.Sections[0].Branches = nullJSON(`[{"condition":{"operator":"equals","question_id":"q1","value":"q1.No"},"go_to":"s3"}]`)
+
+	tests := []struct {
+		description string
+		answers     map[string][]AnswerValue
+		visible     []string
+		hidden      []string
+		lastSection string
+	}{
+		{
+			description: "no answers",
+			visible:     []string{"s1", "s2", "s3", "q1", "q3"},
+			hidden:      []string{"q2", "q4"},
+		},
+		{
+			description: "q1 is yes and q2 includes other",
+			answers: map[string][]AnswerValue{
+				"q1": {{ChoiseID: "q1.Yes"}},
+				"q2": {{ChoiseID: "q2.Price"}, {ChoiseID: "q2.Other"}},
+			},
+			visible: []string{"s1", "s2", "s3", "q1", "q2", "q3", "q4"},
+		},
+		{
+			description: "q1 is no, skip to s3 and ignore the answer of hidden q2",
+			answers: map[string][]AnswerValue{
+				"q1": {{ChoiseID: "q1.No"}},
+				"q2": {{ChoiseID: "q2.Other"}},
+			},
+			visible:     []string{"s1", "s3", "q1"},
+			hidden:      []string{"s2", "q2", "q3", "q4"},
+			lastSection: "s1",
+		},
+	}
+	for _, test := range tests {
+		v := s.Evaluate(test.answers)
+		for _, id := range test.visible {
+			if !v.Sections[id] && !v.Questions[id] {
+				t.Errorf("%s: expected [%s] to be visible", test.description, id)
+			}
+		}
+		for _, id := range test.hidden {
+			if v.Sections[id] || v.Questions[id] {
+				t.Errorf("%s: expected [%s] to be hidden", test.description, id)
+			}
+		}
+		if test.lastSection != "" {
+			next, _ := s.NextSection(test.lastSection, v)
+			if next.ID.String != "s3" {
+				t.Errorf("%s: expected next section [s3], got [%s]", test.description, next.ID.String)
+			}
+		}
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		description string
+		visibleIf   string
+		isValid     bool
+	}{
+		{"empty", `null`, true},
+		{"simple", `{"operator":"equals","question_id":"q1","value":"No"}`, true},
+		{"nested", `{"operator":"or","conditions":[{"operator":"not_answered","question_id":"q1"}]}`, true},
+		{"unsupported operator", `{"operator":"like","question_id":"q1","value":"No"}`, false},
+		{"missing question", `{"operator":"equals","value":"No"}`, false},
+		{"missing value", `{"operator":"greater_than","question_id":"q1"}`, false},
+		{"empty group", `{"operator":"and"}`, false},
+	}
+	for _, test := range tests {
+		n := app.NullJSON{}
+		err := json.Unmarshal([]byte(test.visibleIf), &n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ParseCondition(n)
+		if (err == nil) != test.isValid {
+			t.Errorf("%s: expected valid [%v], got error [%v]", test.description, test.isValid, err)
+		}
+	}
+}
+
+func TestLogicCompareChoice(t *testing.T) {
+	q := Question{}
+	q.ID.Set("q1")
+	for _, text := range []string{"1", "2", "3"} {
+		c := Choise{}
+		c.ID.Set("c" + text)
+		c.ChoiseText.Set(text)
+		q.Choises = append(q.Choises, c)
+	}
+	l := logic{questions: map[string]Question{"q1": q}, answers: map[string][]AnswerValue{"q1": {{ChoiseID: "c2"}}}}
+
+	tests := []struct {
+		operator string
+		value    string
+		expected bool
+	}{
+		{LogicEquals, "c2", true},
+		{LogicEquals, "2", true},
+		{LogicGreaterThan, "c2", false},
+		{LogicGreaterThanOrEqual, "c2", true},
+		{LogicGreaterThan, "1", true},
+		{LogicLessThan, "2", false},
+	}
+	for _, test := range tests {
+		actual := l.evaluate(Condition{Operator: test.operator, QuestionID: "q1", Value: test.value})
+		if actual != test.expected {
+			t.Errorf("expected %s %s to be %v, got %v", test.operator, test.value, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateBranchTargets(t *testing.T) {
+	section := func(id string, position int64, branches string) Section {
+		sec := Section{}
+		sec.ID.Set(id)
+		sec.Position.Set(position)
+		if branches != "" {
+			err := json.Unmarshal([]byte(branches), &sec.Branches)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return sec
+	}
+	branch := func(goTo string) string {
+		return `[{"condition":{"operator":"answered","question_id":"q1"},"go_to":"` + goTo + `"}]`
+	}
+
+	tests := []struct {
+		description string
+		sections    []Section
+		isValid     bool
+	}{
+		{"later section", []Section{section("s1", 1, branch("s3")), section("s2", 2, ""), section("s3", 3, "")}, true},
+		{"end", []Section{section("s1", 1, ""), section("s2", 2, branch(BranchEnd))}, true},
+		{"ordered by position", []Section{section("s2", 2, ""), section("s1", 1, branch("s2"))}, true},
+		{"earlier section", []Section{section("s1", 1, ""), section("s2", 2, branch("s1"))}, false},
+		{"itself", []Section{section("s1", 1, branch("s1")), section("s2", 2, "")}, false},
+		{"unknown section", []Section{section("s1", 1, branch("s9")), section("s2", 2, "")}, false},
+	}
+	for _, test := range tests {
+		err := validateBranchTargets(test.sections)
+		if (err == nil) != test.isValid {
+			t.Errorf("%s: expected valid [%v], got error [%v]", test.description, test.isValid, err)
+		}
+	}
+}
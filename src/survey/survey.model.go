@@ -5,14 +5,23 @@ import "github.com/survey-app/survey/app"
 // Survey is the main model of Survey data. It provides a convenient interface for app.ModelInterface
 type Survey struct {
 	app.Model
-	ID          app.NullUUID     `json:"id"          db:"m.id"              gorm:"column:id;primaryKey"`
-	Title       app.NullString   `json:"title"       db:"m.title"           gorm:"column:title"`
-	Description app.NullString   `json:"description" db:"m.description"     gorm:"column:description"`
-	IsActive    app.NullBool     `json:"is_active"   db:"m.is_active"       gorm:"column:is_active"`
-	CreatedAt   app.NullDateTime `json:"created_at"  db:"m.created_at"      gorm:"column:created_at"`
-	UpdatedAt   app.NullDateTime `json:"updated_at"  db:"m.updated_at"      gorm:"column:updated_at"`
-	DeletedAt   app.NullDateTime `json:"deleted_at"  db:"m.deleted_at,hide" gorm:"column:deleted_at"`
-	Questions   []Question       `json:"questions"   db:"survey.id={id}"    gorm:"-"`
+	ID           app.NullUUID     `json:"id"            db:"m.id"              gorm:"column:id;primaryKey"`
+	Title        app.NullString   `json:"title"         db:"m.title"           gorm:"column:title"`
+	Description  app.NullString   `json:"description"   db:"m.description"     gorm:"column:description"`
+	IsActive     app.NullBool     `json:"is_active"     db:"m.is_active"       gorm:"column:is_active"`
+	Status       app.NullString   `json:"status"        db:"m.status"          gorm:"column:status"`
+	OpensAt      app.NullDateTime `json:"opens_at"      db:"m.opens_at"        gorm:"column:opens_at"`
+	ClosesAt     app.NullDateTime `json:"closes_at"     db:"m.closes_at"       gorm:"column:closes_at"`
+	MaxResponses app.NullInt64    `json:"max_responses" db:"m.max_responses"   gorm:"column:max_responses"`
+	Translations app.NullJSON     `json:"translations"  db:"m.translations"    gorm:"column:translations"`
+	PublishedAt  app.NullDateTime `json:"published_at"  db:"m.published_at"    gorm:"column:published_at"`
+	ClosedAt     app.NullDateTime `json:"closed_at"     db:"m.closed_at"       gorm:"column:closed_at"`
+	CreatedAt    app.NullDateTime `json:"created_at"    db:"m.created_at"      gorm:"column:created_at"`
+	UpdatedAt    app.NullDateTime `json:"updated_at"    db:"m.updated_at"      gorm:"column:updated_at"`
+	DeletedAt    app.NullDateTime `json:"deleted_at"    db:"m.deleted_at,hide" gorm:"column:deleted_at"`
+	Sections     []Section        `json:"sections"      db:"survey.id={id}"    gorm:"-"`
+	Questions    []Question       `json:"questions"     db:"survey.id={id}"    gorm:"-"`
+	Transitions  []Transition     `json:"transitions"   db:"survey.id={id}"    gorm:"-"`
 }
 
 // EndPoint returns the Survey end point, it used for cache key, etc.
@@ -23,7 +32,7 @@ func (Survey) EndPoint() string {
 // TableVersion returns the versions of the Survey table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Survey) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291230"
 }
 
 // TableName returns the name of the Survey table in the database.
@@ -71,18 +80,84 @@ func (Survey) OpenAPISchemaName() string {
 	return "Survey"
 }
 
+type Section struct {
+	app.Model
+	ID          app.NullUUID   `json:"id"          db:"s.id"             gorm:"column:id"`
+	SurveyID    app.NullUUID   `json:"survey.id"   db:"s.survey_id,hide" gorm:"column:survey_id"`
+	Title       app.NullString `json:"title"       db:"s.title"          gorm:"column:title"`
+	Description app.NullText   `json:"description" db:"s.description"    gorm:"column:description"`
+	Position    app.NullInt64  `json:"position"    db:"s.position"       gorm:"column:position"`
+	VisibleIf   app.NullJSON   `json:"visible_if"  db:"s.visible_if"     gorm:"column:visible_if"`
+	Branches    app.NullJSON   `json:"branches"    db:"s.branches"       gorm:"column:branches"`
+	Questions   []Question     `json:"questions"   db:"section.id={id}"  gorm:"-"`
+}
+
+// TableVersion returns the versions of the sections table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Section) TableVersion() string {
+	return "26.10.181230"
+}
+
+// TableName returns the name of the sections table in the database.
+func (Section) TableName() string {
+	return "sections"
+}
+
+// TableAliasName returns the table alias name of the sections table, used for querying.
+func (Section) TableAliasName() string {
+	return "s"
+}
+
+// GetRelations returns the relations of the sections data in the database, used for querying.
+func (m *Section) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the sections data in the database, used for querying.
+func (m *Section) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "s.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the sections data in the database, used for querying.
+func (m *Section) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "s.position", "direction": "asc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the sections data in the database, used for querying.
+func (m *Section) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the sections schema, used for querying.
+func (m *Section) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
 type Question struct {
 	app.Model
-	ID           app.NullUUID `json:"id"            db:"q.id"             gorm:"column:id"`
-	SurveyID     app.NullUUID `json:"survey.id"     db:"q.survey_id,hide" gorm:"column:survey_id"`
-	QuestionText app.NullText `json:"question_text" db:"q.question_text"  gorm:"column:question_text"`
-	Choises      []Choise     `json:"choices"       db:"question.id={id}" gorm:"-"`
+	ID             app.NullUUID   `json:"id"               db:"q.id"               gorm:"column:id"`
+	SurveyID       app.NullUUID   `json:"survey.id"        db:"q.survey_id,hide"   gorm:"column:survey_id"`
+	SectionID      app.NullUUID   `json:"section.id"       db:"q.section_id,hide"  gorm:"column:section_id"`
+	Code           app.NullString `json:"code"             db:"q.code"             gorm:"column:code"`
+	QuestionText   app.NullText   `json:"question_text"    db:"q.question_text"    gorm:"column:question_text"`
+	Type           app.NullString `json:"type"             db:"q.type"             gorm:"column:type"`
+	Config         app.NullJSON   `json:"config"           db:"q.config"           gorm:"column:config"`
+	IsRequired     app.NullBool   `json:"is_required"      db:"q.is_required"      gorm:"column:is_required"`
+	Validation     app.NullJSON   `json:"validation"       db:"q.validation"       gorm:"column:validation"`
+	Position       app.NullInt64  `json:"position"         db:"q.position"         gorm:"column:position"`
+	VisibleIf      app.NullJSON   `json:"visible_if"       db:"q.visible_if"       gorm:"column:visible_if"`
+	BankQuestionID app.NullUUID   `json:"bank_question_id" db:"q.bank_question_id" gorm:"column:bank_question_id"`
+	Choises        []Choise       `json:"choices"          db:"question.id={id}"   gorm:"-"`
+	Rows           []Row          `json:"rows"             db:"question.id={id}"   gorm:"-"`
 }
 
 // TableVersion returns the versions of the questions table in the database.
 // Change this value with date format YY.MM.DDHHii when any table structure changes.
 func (Question) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291210"
 }
 
 // TableName returns the name of the questions table in the database.
@@ -102,9 +177,16 @@ func (m *Question) GetRelations() map[string]map[string]any {
 
 // GetFilters returns the filter of the questions data in the database, used for querying.
 func (m *Question) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "q.deleted_at", "operator": "=", "value": nil})
 	return m.Filters
 }
 
+// GetSorts returns the default sort of the questions data in the database, used for querying.
+func (m *Question) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "q.position", "direction": "asc"})
+	return m.Sorts
+}
+
 // GetFields returns list of the field of the questions data in the database, used for querying.
 func (m *Question) GetFields() map[string]map[string]any {
 	m.SetFields(m)
@@ -118,13 +200,14 @@ func (m *Question) GetSchema() map[string]any {
 
 type Choise struct {
 	app.Model
-	ID         app.NullUUID `json:"id"          db:"c.id"               gorm:"column:id"`
-	QuestionID app.NullUUID `json:"question.id" db:"c.question_id,hide" gorm:"column:question_id"`
-	ChoiseText app.NullText `json:"choise_text" db:"c.choise_text"      gorm:"column:choise_text"`
+	ID         app.NullUUID  `json:"id"          db:"c.id"               gorm:"column:id"`
+	QuestionID app.NullUUID  `json:"question.id" db:"c.question_id,hide" gorm:"column:question_id"`
+	ChoiseText app.NullText  `json:"choise_text" db:"c.choise_text"      gorm:"column:choise_text"`
+	Position   app.NullInt64 `json:"position"    db:"c.position"         gorm:"column:position"`
 }
 
 func (Choise) TableVersion() string {
-	return "28.06.291152"
+	return "28.06.291220"
 }
 
 func (Choise) TableName() string {
@@ -140,9 +223,15 @@ func (m *Choise) GetRelations() map[string]map[string]any {
 }
 
 func (m *Choise) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "c.deleted_at", "operator": "=", "value": nil})
 	return m.Filters
 }
 
+func (m *Choise) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "c.position", "direction": "asc"})
+	return m.Sorts
+}
+
 func (m *Choise) GetFields() map[string]map[string]any {
 	m.SetFields(m)
 	return m.Fields
@@ -152,6 +241,59 @@ func (m *Choise) GetSchema() map[string]any {
 	return m.SetSchema(m)
 }
 
+// Row is the row item of the matrix question, answered on the choices (columns) of the question.
+type Row struct {
+	app.Model
+	ID         app.NullUUID  `json:"id"          db:"r.id"               gorm:"column:id"`
+	QuestionID app.NullUUID  `json:"question.id" db:"r.question_id,hide" gorm:"column:question_id"`
+	RowText    app.NullText  `json:"row_text"    db:"r.row_text"         gorm:"column:row_text"`
+	Position   app.NullInt64 `json:"position"    db:"r.position"         gorm:"column:position"`
+}
+
+// TableVersion returns the versions of the question_rows table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Row) TableVersion() string {
+	return "26.10.181600"
+}
+
+// TableName returns the name of the question_rows table in the database.
+func (Row) TableName() string {
+	return "question_rows"
+}
+
+// TableAliasName returns the table alias name of the question_rows table, used for querying.
+func (Row) TableAliasName() string {
+	return "r"
+}
+
+// GetRelations returns the relations of the question_rows data in the database, used for querying.
+func (m *Row) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the question_rows data in the database, used for querying.
+func (m *Row) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "r.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the question_rows data in the database, used for querying.
+func (m *Row) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "r.position", "direction": "asc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the question_rows data in the database, used for querying.
+func (m *Row) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the question_rows schema, used for querying.
+func (m *Row) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
 // ParamCreate is the expected parameters for create a new Survey data.
 type ParamCreate struct {
 	UseCaseHandler
@@ -174,3 +316,22 @@ type ParamDelete struct {
 	UseCaseHandler
 	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
 }
+
+// ParamReorderQuestions is the expected parameters for reorder the questions of the Survey data.
+type ParamReorderQuestions struct {
+	QuestionIDs []string       `json:"question_ids" validate:"required,min=1,dive,uuid"`
+	Reason      app.NullString `json:"reason"       validate:"required"`
+}
+
+// ParamDuplicate is the expected parameters for duplicate the Survey data, the title of the original Survey is used when the title is undefined
+// and the translations and the response limit (max_responses) are copied unless include_translations or include_quotas is false.
+type ParamDuplicate struct {
+	Title               app.NullString `json:"title"`
+	IncludeTranslations app.NullBool   `json:"include_translations"`
+	IncludeQuotas       app.NullBool   `json:"include_quotas"`
+}
+
+// ParamTransition is the expected parameters for apply a lifecycle action (publish, close, archive, reopen) to the Survey data.
+type ParamTransition struct {
+	Reason app.NullString `json:"reason" validate:"required"`
+}
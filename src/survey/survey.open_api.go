@@ -121,3 +121,165 @@ func (o *OpenAPIOperation) DeleteByID() *OpenAPIOperation {
 	o.Body = map[string]any{"application/json": &ParamDelete{}}
 	return o
 }
+
+// ReorderQuestions is detail of `POST /api/v1/surveys/{id}/questions/reorder` open api document component.
+func (o *OpenAPIOperation) ReorderQuestions() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Reorder Survey Questions"
+	o.Description = "Use this method to rewrite the position of every question of the Survey by id"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamReorderQuestions{}}
+	return o
+}
+
+// Publish is detail of `POST /api/v1/surveys/{id}/publish` open api document component.
+func (o *OpenAPIOperation) Publish() *OpenAPIOperation {
+	return o.transition("Publish Survey", "Use this method to publish the draft Survey by id, only the published Survey accepts responses and its questions are no longer editable until it is revised")
+}
+
+// Close is detail of `POST /api/v1/surveys/{id}/close` open api document component.
+func (o *OpenAPIOperation) Close() *OpenAPIOperation {
+	return o.transition("Close Survey", "Use this method to close the published Survey by id, the closed Survey no longer accepts responses")
+}
+
+// Archive is detail of `POST /api/v1/surveys/{id}/archive` open api document component.
+func (o *OpenAPIOperation) Archive() *OpenAPIOperation {
+	return o.transition("Archive Survey", "Use this method to archive the draft or closed Survey by id")
+}
+
+// Reopen is detail of `POST /api/v1/surveys/{id}/reopen` open api document component.
+func (o *OpenAPIOperation) Reopen() *OpenAPIOperation {
+	return o.transition("Reopen Survey", "Use this method to publish the closed or archived Survey by id again")
+}
+
+// Revise is detail of `POST /api/v1/surveys/{id}/revise` open api document component.
+func (o *OpenAPIOperation) Revise() *OpenAPIOperation {
+	return o.transition("Revise Survey", "Use this method to return the published or closed Survey by id to draft, so its sections, questions and choices are editable again and published as the next version")
+}
+
+// transition is the common detail of the lifecycle action open api document component.
+func (o *OpenAPIOperation) transition(summary, description string) *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = summary
+	o.Description = description
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamTransition{}}
+	return o
+}
+
+// GetVersions is detail of `GET /api/v1/surveys/{id}/versions` open api document component.
+func (o *OpenAPIOperation) GetVersions() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Survey Versions"
+	o.Description = "Use this method to get list of the published versions of the Survey by id, along with the structure of each version"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.QueryParams = []map[string]any{{"$ref": "#/components/parameters/queryParam.Any"}}
+	type VersionList struct {
+		app.ListModel
+		Data []Version `json:"results"`
+	}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &VersionList{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
+
+// DiffVersions is detail of `GET /api/v1/surveys/{id}/versions/diff` open api document component.
+func (o *OpenAPIOperation) DiffVersions() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Diff Survey Versions"
+	o.Description = "Use this method to get the added, removed and changed sections, questions and choices between two versions of the Survey by id"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.QueryParams = []map[string]any{
+		{"name": "from", "in": "query", "required": true, "description": "The version number to compare from", "schema": map[string]any{"type": "integer"}},
+		{"name": "to", "in": "query", "required": true, "description": "The version number to compare to", "schema": map[string]any{"type": "integer"}},
+	}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &VersionDiff{}},
+		},
+		"400": app.OpenAPIError().BadRequest(),
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
+
+// Duplicate is detail of `POST /api/v1/surveys/{id}/duplicate` open api document component.
+func (o *OpenAPIOperation) Duplicate() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Duplicate Survey"
+	o.Description = "Use this method to copy the Survey by id along with its sections, questions, choices and logic under fresh ids. " +
+		"The copy always starts as draft without the opens_at and closes_at schedule. " +
+		"The translations and the response limit (max_responses) are copied too, " +
+		"set include_translations or include_quotas to false to leave them out."
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &ParamDuplicate{}}
+	return o
+}
+
+// GetDefinition is detail of `GET /api/v1/surveys/{id}/definition` open api document component.
+func (o *OpenAPIOperation) GetDefinition() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Get Survey Definition"
+	o.Description = "Use this method to get the complete definition of the Survey by id as a portable JSON document, " +
+		"the survey, settings, sections, questions, choices, rows, logic and translations under the schema_version. " +
+		"The document is canonical, so it can be kept under version control and applied to other environments"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Responses = map[string]map[string]any{
+		"200": {
+			"description": "Success",
+			"content":     map[string]any{"application/json": &Definition{}},
+		},
+		"401": app.OpenAPIError().Unauthorized(),
+		"403": app.OpenAPIError().Forbidden(),
+	}
+	return o
+}
+
+// UpdateDefinition is detail of `PUT /api/v1/surveys/{id}/definition` open api document component.
+func (o *OpenAPIOperation) UpdateDefinition() *OpenAPIOperation {
+	if !app.IS_GENERATE_OPEN_API_DOC {
+		return o // skip for efficiency
+	}
+
+	o.Base()
+	o.Summary = "Update Survey Definition"
+	o.Description = "Use this method to replace the draft Survey by id with the definition got from `GET /api/v1/surveys/{id}/definition`. " +
+		"The ids of the definition are kept, the sections, questions, choices and rows missing from the definition are removed, " +
+		"and applying the same definition again changes nothing. The reference to the bank question missing from this environment is removed"
+	o.PathParams = []map[string]any{{"$ref": "#/components/parameters/pathParam.ID"}}
+	o.Body = map[string]any{"application/json": &Definition{}}
+	return o
+}
@@ -0,0 +1,216 @@
+package survey
+
+import (
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+// The supported question types, stored on the type column of the questions table.
+const (
+	QuestionTypeSingleChoice   = "single_choice"
+	QuestionTypeMultipleChoice = "multiple_choice"
+	QuestionTypeShortText      = "short_text"
+	QuestionTypeLongText       = "long_text"
+	QuestionTypeNumber         = "number"
+	QuestionTypeDate           = "date"
+	QuestionTypeRating         = "rating"
+	QuestionTypeFileUpload     = "file_upload"
+	QuestionTypeMatrix         = "matrix"
+	QuestionTypeNPS            = "nps"
+	QuestionTypeRanking        = "ranking"
+)
+
+// The fixed scale of the nps (Net Promoter Score) question, the score from NPSPromoterMin is a promoter,
+// from NPSPassiveMin is a passive and below it is a detractor.
+const (
+	NPSScaleMin    = 0
+	NPSScaleMax    = 10
+	NPSPassiveMin  = 7
+	NPSPromoterMin = 9
+)
+
+// DateFormat is the expected format of the answer of date question.
+const DateFormat = "2006-01-02"
+
+// QuestionTypes returns the list of supported question types.
+func QuestionTypes() []string {
+	return []string{
+		QuestionTypeSingleChoice,
+		QuestionTypeMultipleChoice,
+		QuestionTypeShortText,
+		QuestionTypeLongText,
+		QuestionTypeNumber,
+		QuestionTypeDate,
+		QuestionTypeRating,
+		QuestionTypeFileUpload,
+		QuestionTypeMatrix,
+		QuestionTypeNPS,
+		QuestionTypeRanking,
+	}
+}
+
+// IsValidQuestionType reports whether t is one of the supported question types.
+func IsValidQuestionType(t string) bool {
+	for _, qt := range QuestionTypes() {
+		if qt == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsChoiceQuestionType reports whether the question of type t is answered by selecting choices,
+// the matrix question is answered by selecting a choice (column) on each of its rows,
+// and the ranking question is answered by ranking all of its choices.
+func IsChoiceQuestionType(t string) bool {
+	return t == QuestionTypeSingleChoice || t == QuestionTypeMultipleChoice || t == QuestionTypeMatrix || t == QuestionTypeRanking
+}
+
+// QuestionConfig is the per type settings of a question, stored on the config column of the questions table.
+type QuestionConfig struct {
+	Placeholder       string   `json:"placeholder,omitempty"`        // short_text, long_text, number
+	IsInteger         bool     `json:"is_integer,omitempty"`         // number
+	ScaleMin          *int64   `json:"scale_min,omitempty"`          // rating, default 1
+	ScaleMax          *int64   `json:"scale_max,omitempty"`          // rating, default 5
+	ScaleMinLabel     string   `json:"scale_min_label,omitempty"`    // rating, nps
+	ScaleMaxLabel     string   `json:"scale_max_label,omitempty"`    // rating, nps
+	AllowedExtensions []string `json:"allowed_extensions,omitempty"` // file_upload, for example [".pdf", ".png"]
+}
+
+// ParseQuestionConfig returns the QuestionConfig of the config column.
+func ParseQuestionConfig(config app.NullJSON) (QuestionConfig, error) {
+	c := QuestionConfig{}
+	err := config.Unmarshal(&c)
+	return c, err
+}
+
+// Scale returns the minimum and maximum value of the rating scale.
+func (c QuestionConfig) Scale() (int64, int64) {
+	min, max := int64(1), int64(5)
+	if c.ScaleMin != nil {
+		min = *c.ScaleMin
+	}
+	if c.ScaleMax != nil {
+		max = *c.ScaleMax
+	}
+	return min, max
+}
+
+// ValidateAnswer validates whether the choice id or the answer text fits the question type.
+// Question without type (created before the question type exists) accepts any answer.
+func ValidateAnswer(ctx *app.Ctx, questionType string, config app.NullJSON, choiseID, answerText string) error {
+	if questionType == "" {
+		return nil
+	}
+	c, err := ParseQuestionConfig(config)
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	params := map[string]string{"type": questionType}
+	answerText = strings.TrimSpace(answerText)
+
+	if IsChoiceQuestionType(questionType) {
+		if choiseID == "" {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_choice_required", params))
+		}
+		return nil
+	}
+	if choiseID != "" {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_choice_not_allowed", params))
+	}
+	if answerText == "" {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_text_required", params))
+	}
+
+	switch questionType {
+	case QuestionTypeNumber:
+		if c.IsInteger {
+			_, err = strconv.ParseInt(answerText, 10, 64)
+		} else {
+			_, err = strconv.ParseFloat(answerText, 64)
+		}
+		if err != nil {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_number_invalid", params))
+		}
+	case QuestionTypeDate:
+		_, err = time.Parse(DateFormat, answerText)
+		if err != nil {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_date_invalid", params))
+		}
+	case QuestionTypeRating:
+		min, max := c.Scale()
+		val, err := strconv.ParseInt(answerText, 10, 64)
+		if err != nil || val < min || val > max {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_rating_invalid", map[string]string{
+				"min": strconv.FormatInt(min, 10),
+				"max": strconv.FormatInt(max, 10),
+			}))
+		}
+	case QuestionTypeNPS:
+		val, err := strconv.ParseInt(answerText, 10, 64)
+		if err != nil || val < NPSScaleMin || val > NPSScaleMax {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_rating_invalid", map[string]string{
+				"min": strconv.Itoa(NPSScaleMin),
+				"max": strconv.Itoa(NPSScaleMax),
+			}))
+		}
+	case QuestionTypeFileUpload:
+		if len(c.AllowedExtensions) > 0 {
+			ext := strings.ToLower(filepath.Ext(answerText))
+			isAllowed := false
+			for _, e := range c.AllowedExtensions {
+				if strings.ToLower(e) == ext {
+					isAllowed = true
+					break
+				}
+			}
+			if !isAllowed {
+				return app.NewError(http.StatusBadRequest, ctx.Trans("answer_file_extension_invalid", map[string]string{
+					"extensions": strings.Join(c.AllowedExtensions, ", "),
+				}))
+			}
+		}
+	}
+	return nil
+}
+
+// NPSCategory returns the category of the nps score, it is "promoter", "passive" or "detractor".
+func NPSCategory(score int64) string {
+	if score >= NPSPromoterMin {
+		return "promoter"
+	}
+	if score >= NPSPassiveMin {
+		return "passive"
+	}
+	return "detractor"
+}
+
+// ValidateAnswerRow validates whether the row id fits the question type, only the answer of the matrix question refers to a row.
+func ValidateAnswerRow(ctx *app.Ctx, questionType, rowID string) error {
+	params := map[string]string{"type": questionType}
+	if questionType == QuestionTypeMatrix && rowID == "" {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_row_required", params))
+	}
+	if questionType != QuestionTypeMatrix && rowID != "" {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_row_not_allowed", params))
+	}
+	return nil
+}
+
+// ValidateAnswerRank validates whether the rank fits the question type, only the answer of the ranking question has a rank,
+// starting from 1 for the most preferred choice.
+func ValidateAnswerRank(ctx *app.Ctx, questionType string, rank int64) error {
+	params := map[string]string{"type": questionType}
+	if questionType == QuestionTypeRanking && rank < 1 {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_rank_required", params))
+	}
+	if questionType != QuestionTypeRanking && rank != 0 {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_rank_not_allowed", params))
+	}
+	return nil
+}
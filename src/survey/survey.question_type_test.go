@@ -0,0 +1,56 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestValidateAnswer(t *testing.T) {
+	ctx := &app.Ctx{Lang: "en"}
+	choiseID := app.NewNullUUID().String
+	ratingConfig := app.NullJSON{}
+	err := json.Unmarshal([]byte(`{"scale_min":0,"scale_max":10}`), &ratingConfig)
+	if err != nil {
+		t.Fatalf("Error occurred [%v]", err)
+	}
+
+	tests := []struct {
+		description  string
+		questionType string
+		config       app.NullJSON
+		choiseID     string
+		answerText   string
+		isValid      bool
+	}{
+		{"legacy question without type", "", app.NullJSON{}, choiseID, "anything", true},
+		{"single choice with choice", QuestionTypeSingleChoice, app.NullJSON{}, choiseID, "", true},
+		{"single choice without choice", QuestionTypeSingleChoice, app.NullJSON{}, "", "Yes", false},
+		{"multiple choice with choice", QuestionTypeMultipleChoice, app.NullJSON{}, choiseID, "", true},
+		{"short text with text", QuestionTypeShortText, app.NullJSON{}, "", "John", true},
+		{"short text with choice", QuestionTypeShortText, app.NullJSON{}, choiseID, "John", false},
+		{"long text with empty text", QuestionTypeLongText, app.NullJSON{}, "", "  ", false},
+		{"number with number", QuestionTypeNumber, app.NullJSON{}, "", "12.5", true},
+		{"number with free text", QuestionTypeNumber, app.NullJSON{}, "", "twelve", false},
+		{"date with date", QuestionTypeDate, app.NullJSON{}, "", "2023-04-01", true},
+		{"date with invalid date", QuestionTypeDate, app.NullJSON{}, "", "01/04/2023", false},
+		{"rating within default scale", QuestionTypeRating, app.NullJSON{}, "", "5", true},
+		{"rating outside default scale", QuestionTypeRating, app.NullJSON{}, "", "6", false},
+		{"rating within configured scale", QuestionTypeRating, ratingConfig, "", "0", true},
+		{"nps at the lowest score", QuestionTypeNPS, app.NullJSON{}, "", "0", true},
+		{"nps at the highest score", QuestionTypeNPS, app.NullJSON{}, "", "10", true},
+		{"nps outside the scale", QuestionTypeNPS, app.NullJSON{}, "", "11", false},
+		{"nps with fraction", QuestionTypeNPS, app.NullJSON{}, "", "7.5", false},
+		{"file upload with file name", QuestionTypeFileUpload, app.NullJSON{}, "", "cv.pdf", true},
+	}
+	for _, test := range tests {
+		err := ValidateAnswer(ctx, test.questionType, test.config, test.choiseID, test.answerText)
+		if test.isValid && err != nil {
+			t.Errorf("%s: expected valid answer, got [%v]", test.description, err)
+		}
+		if !test.isValid && err == nil {
+			t.Errorf("%s: expected invalid answer, got nil error", test.description)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package survey
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/survey-app/survey/app"
+)
+
+// QuestionValidation is the validation rules of the answer of a question, stored on the validation column of the questions table.
+// The same rules are exposed on the survey detail so the front-end can validate the answers before submitting them.
+type QuestionValidation struct {
+	MinLength   *int     `json:"min_length,omitempty"`   // short_text, long_text
+	MaxLength   *int     `json:"max_length,omitempty"`   // short_text, long_text
+	Pattern     string   `json:"pattern,omitempty"`      // short_text, long_text, regular expression (RE2 syntax)
+	Min         *float64 `json:"min,omitempty"`          // number, rating, nps
+	Max         *float64 `json:"max,omitempty"`          // number, rating, nps
+	MinSelected *int     `json:"min_selected,omitempty"` // multiple_choice, matrix (the number of the answered rows)
+	MaxSelected *int     `json:"max_selected,omitempty"` // multiple_choice, matrix (the number of the answered rows)
+	MinDate     string   `json:"min_date,omitempty"`     // date, with format YYYY-MM-DD
+	MaxDate     string   `json:"max_date,omitempty"`     // date, with format YYYY-MM-DD
+
+	pattern *regexp.Regexp
+}
+
+// ParseQuestionValidation returns the QuestionValidation of the validation column, it returns error when the rules are malformed.
+func ParseQuestionValidation(validation app.NullJSON) (QuestionValidation, error) {
+	v := QuestionValidation{}
+	err := validation.Unmarshal(&v)
+	if err != nil {
+		return v, err
+	}
+	if v.Pattern != "" {
+		v.pattern, err = regexp.Compile(v.Pattern)
+		if err != nil {
+			return v, err
+		}
+	}
+	for _, d := range []string{v.MinDate, v.MaxDate} {
+		if d != "" {
+			_, err = time.Parse(DateFormat, d)
+			if err != nil {
+				return v, err
+			}
+		}
+	}
+	return v, nil
+}
+
+// ValidateQuestionValidation returns bad request error when the validation rules are malformed.
+func ValidateQuestionValidation(ctx *app.Ctx, validation app.NullJSON) error {
+	_, err := ParseQuestionValidation(validation)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_question_validation", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// ValidateAnswerText validates the answer text of a question with type questionType against the rules.
+func (v QuestionValidation) ValidateAnswerText(ctx *app.Ctx, questionType, answerText string) error {
+	if IsChoiceQuestionType(questionType) {
+		return nil
+	}
+	answerText = strings.TrimSpace(answerText)
+
+	switch questionType {
+	case QuestionTypeNumber, QuestionTypeRating, QuestionTypeNPS:
+		val, err := strconv.ParseFloat(answerText, 64)
+		if err != nil {
+			return nil // already validated by ValidateAnswer
+		}
+		if v.Min != nil && val < *v.Min {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_min", map[string]string{
+				"min": strconv.FormatFloat(*v.Min, 'f', -1, 64),
+			}))
+		}
+		if v.Max != nil && val > *v.Max {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_max", map[string]string{
+				"max": strconv.FormatFloat(*v.Max, 'f', -1, 64),
+			}))
+		}
+	case QuestionTypeDate:
+		// the date format is already validated by ValidateAnswer, so it can be compared as string
+		if v.MinDate != "" && answerText < v.MinDate {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_min_date", map[string]string{"min": v.MinDate}))
+		}
+		if v.MaxDate != "" && answerText > v.MaxDate {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_max_date", map[string]string{"max": v.MaxDate}))
+		}
+	default:
+		length := utf8.RuneCountInString(answerText)
+		if v.MinLength != nil && length < *v.MinLength {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_min_length", map[string]string{
+				"min": strconv.Itoa(*v.MinLength),
+			}))
+		}
+		if v.MaxLength != nil && length > *v.MaxLength {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_max_length", map[string]string{
+				"max": strconv.Itoa(*v.MaxLength),
+			}))
+		}
+		if v.pattern != nil && !v.pattern.MatchString(answerText) {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_pattern_mismatch"))
+		}
+	}
+	return nil
+}
+
+// ValidateSelectedCount validates the number of selected choices against the rules.
+func (v QuestionValidation) ValidateSelectedCount(ctx *app.Ctx, count int) error {
+	if v.MinSelected != nil && count < *v.MinSelected {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_min_selected", map[string]string{
+			"min": strconv.Itoa(*v.MinSelected),
+		}))
+	}
+	if v.MaxSelected != nil && count > *v.MaxSelected {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_max_selected", map[string]string{
+			"max": strconv.Itoa(*v.MaxSelected),
+		}))
+	}
+	return nil
+}
+
+// AnswerValue is the value of an answer to be validated against a question.
+type AnswerValue struct {
+	ChoiseID   string
+	RowID      string // the row of the matrix question
+	Rank       int64  // the rank of the choice of the ranking question, starting from 1
+	AnswerText string
+}
+
+// ValidateAnswers validates all of the answers of the question q against its choices, rows, type, required flag and validation rules.
+// The required matrix question must be answered on all of its rows, each row is answered once.
+// The answered ranking question must rank all of its choices, each choice once under a unique rank.
+func (q Question) ValidateAnswers(ctx *app.Ctx, answers []AnswerValue) error {
+	if len(answers) == 0 {
+		if q.IsRequired.Bool {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("question_required"))
+		}
+		return nil
+	}
+	if len(answers) > 1 && q.Type.String != QuestionTypeMultipleChoice && q.Type.String != QuestionTypeMatrix && q.Type.String != QuestionTypeRanking {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("answer_duplicated", map[string]string{
+			"question_id": q.ID.String,
+		}))
+	}
+
+	v, err := ParseQuestionValidation(q.Validation)
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	rowIDs, rankedChoiseIDs, ranks := map[string]bool{}, map[string]bool{}, map[int64]bool{}
+	for _, a := range answers {
+		if a.ChoiseID != "" && !q.HasChoise(a.ChoiseID) {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("choice_not_in_question", map[string]string{
+				"choise_id":   a.ChoiseID,
+				"question_id": q.ID.String,
+			}))
+		}
+		if a.RowID != "" && !q.HasRow(a.RowID) {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("row_not_in_question", map[string]string{
+				"row_id":      a.RowID,
+				"question_id": q.ID.String,
+			}))
+		}
+		if a.RowID != "" && rowIDs[a.RowID] {
+			return app.NewError(http.StatusBadRequest, ctx.Trans("answer_row_duplicated", map[string]string{
+				"row_id": a.RowID,
+			}))
+		}
+		rowIDs[a.RowID] = true
+		if q.Type.String == QuestionTypeRanking {
+			if rankedChoiseIDs[a.ChoiseID] {
+				return app.NewError(http.StatusBadRequest, ctx.Trans("answer_choice_duplicated", map[string]string{
+					"choise_id": a.ChoiseID,
+				}))
+			}
+			rankedChoiseIDs[a.ChoiseID] = true
+			if ranks[a.Rank] || a.Rank > int64(len(q.Choises)) {
+				return app.NewError(http.StatusBadRequest, ctx.Trans("answer_rank_invalid", map[string]string{
+					"rank": strconv.FormatInt(a.Rank, 10),
+					"max":  strconv.Itoa(len(q.Choises)),
+				}))
+			}
+			ranks[a.Rank] = true
+		}
+		err = ValidateAnswer(ctx, q.Type.String, q.Config, a.ChoiseID, a.AnswerText)
+		if err != nil {
+			return err
+		}
+		err = ValidateAnswerRow(ctx, q.Type.String, a.RowID)
+		if err != nil {
+			return err
+		}
+		err = ValidateAnswerRank(ctx, q.Type.String, a.Rank)
+		if err != nil {
+			return err
+		}
+		err = v.ValidateAnswerText(ctx, q.Type.String, a.AnswerText)
+		if err != nil {
+			return err
+		}
+	}
+	if q.Type.String == QuestionTypeMatrix && q.IsRequired.Bool && len(rowIDs) < len(q.Rows) {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("matrix_rows_required"))
+	}
+	if q.Type.String == QuestionTypeRanking && len(rankedChoiseIDs) < len(q.Choises) {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("ranking_choices_required"))
+	}
+	if IsChoiceQuestionType(q.Type.String) {
+		return v.ValidateSelectedCount(ctx, len(answers))
+	}
+	return nil
+}
+
+// HasChoise reports whether the choice with the specified id belongs to the question q.
+func (q Question) HasChoise(id string) bool {
+	for _, c := range q.Choises {
+		if c.ID.String == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRow reports whether the row with the specified id belongs to the matrix question q.
+func (q Question) HasRow(id string) bool {
+	for _, r := range q.Rows {
+		if r.ID.String == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestQuestionValidateAnswers(t *testing.T) {
+	ctx := &app.Ctx{Lang: "en"}
+	newQuestion := func(questionType string, isRequired bool, validation string) Question {
+		q := Question{}
+		q.ID = app.NewNullUUID()
+		q.Type = app.NewNullString(questionType)
+		q.IsRequired = app.NewNullBool(isRequired)
+		if validation != "" {
+			err := json.Unmarshal([]byte(validation), &q.Validation)
+			if err != nil {
+				t.Fatalf("Error occurred [%v]", err)
+			}
+		}
+		for i := 0; i < 3; i++ {
+			c := Choise{}
+			c.ID = app.NewNullUUID()
+			q.Choises = append(q.Choises, c)
+		}
+		return q
+	}
+	multiple := newQuestion(QuestionTypeMultipleChoice, true, `{"min_selected":2}`)
+	matrix := newQuestion(QuestionTypeMatrix, true, "")
+	for i := 0; i < 2; i++ {
+		r := Row{}
+		r.ID = app.NewNullUUID()
+		matrix.Rows = append(matrix.Rows, r)
+	}
+	column, row1, row2 := matrix.Choises[0].ID.String, matrix.Rows[0].ID.String, matrix.Rows[1].ID.String
+	single := newQuestion(QuestionTypeSingleChoice, true, "")
+	ranking := newQuestion(QuestionTypeRanking, false, "")
+	first, second, third := ranking.Choises[0].ID.String, ranking.Choises[1].ID.String, ranking.Choises[2].ID.String
+
+	tests := []struct {
+		description string
+		question    Question
+		answers     []AnswerValue
+		isValid     bool
+	}{
+		{"optional question without answer", newQuestion(QuestionTypeShortText, false, ""), nil, true},
+		{"required question without answer", newQuestion(QuestionTypeShortText, true, ""), nil, false},
+		{"text shorter than min length", newQuestion(QuestionTypeShortText, true, `{"min_length":3}`), []AnswerValue{{AnswerText: "ab"}}, false},
+		{"text not match the pattern", newQuestion(QuestionTypeShortText, true, `{"pattern":"^[0-9]+$"}`), []AnswerValue{{AnswerText: "12a"}}, false},
+		{"text match the pattern", newQuestion(QuestionTypeShortText, true, `{"pattern":"^[0-9]+$"}`), []AnswerValue{{AnswerText: "123"}}, true},
+		{"number greater than max", newQuestion(QuestionTypeNumber, true, `{"max":100}`), []AnswerValue{{AnswerText: "101"}}, false},
+		{"date before min date", newQuestion(QuestionTypeDate, true, `{"min_date":"2023-01-01"}`), []AnswerValue{{AnswerText: "2022-12-31"}}, false},
+		{"two answers of single choice", newQuestion(QuestionTypeSingleChoice, true, ""), []AnswerValue{{ChoiseID: "a"}, {ChoiseID: "b"}}, false},
+		{"choice of another question", newQuestion(QuestionTypeSingleChoice, true, ""), []AnswerValue{{ChoiseID: app.NewNullUUID().String}}, false},
+		{"fewer selected choices than min selected", multiple, []AnswerValue{{ChoiseID: multiple.Choises[0].ID.String}}, false},
+		{"enough selected choices", multiple, []AnswerValue{{ChoiseID: multiple.Choises[0].ID.String}, {ChoiseID: multiple.Choises[1].ID.String}}, true},
+		{"matrix answered on all rows", matrix, []AnswerValue{{ChoiseID: column, RowID: row1}, {ChoiseID: column, RowID: row2}}, true},
+		{"required matrix with unanswered row", matrix, []AnswerValue{{ChoiseID: column, RowID: row1}}, false},
+		{"matrix row answered twice", matrix, []AnswerValue{{ChoiseID: column, RowID: row1}, {ChoiseID: column, RowID: row1}}, false},
+		{"matrix answer without row", matrix, []AnswerValue{{ChoiseID: column}, {ChoiseID: column, RowID: row2}}, false},
+		{"matrix row of another question", matrix, []AnswerValue{{ChoiseID: column, RowID: row1}, {ChoiseID: column, RowID: app.NewNullUUID().String}}, false},
+		{"ranking of all choices", ranking, []AnswerValue{{ChoiseID: first, Rank: 2}, {ChoiseID: second, Rank: 1}, {ChoiseID: third, Rank: 3}}, true},
+		{"unanswered optional ranking", ranking, []AnswerValue{}, true},
+		{"ranking of some choices", ranking, []AnswerValue{{ChoiseID: first, Rank: 1}, {ChoiseID: second, Rank: 2}}, false},
+		{"ranking with duplicated rank", ranking, []AnswerValue{{ChoiseID: first, Rank: 1}, {ChoiseID: second, Rank: 1}, {ChoiseID: third, Rank: 3}}, false},
+		{"ranking with duplicated choice", ranking, []AnswerValue{{ChoiseID: first, Rank: 1}, {ChoiseID: first, Rank: 2}, {ChoiseID: third, Rank: 3}}, false},
+		{"ranking with rank outside the choices", ranking, []AnswerValue{{ChoiseID: first, Rank: 1}, {ChoiseID: second, Rank: 2}, {ChoiseID: third, Rank: 4}}, false},
+		{"ranking without rank", ranking, []AnswerValue{{ChoiseID: first}, {ChoiseID: second, Rank: 2}, {ChoiseID: third, Rank: 3}}, false},
+		{"single choice answer with rank", single, []AnswerValue{{ChoiseID: single.Choises[0].ID.String, Rank: 1}}, false},
+		{"single choice answer with row", single, []AnswerValue{{ChoiseID: single.Choises[0].ID.String, RowID: row1}}, false},
+	}
+	for _, test := range tests {
+		err := test.question.ValidateAnswers(ctx, test.answers)
+		if test.isValid && err != nil {
+			t.Errorf("%s: expected valid answers, got [%v]", test.description, err)
+		}
+		if !test.isValid && err == nil {
+			t.Errorf("%s: expected invalid answers, got nil error", test.description)
+		}
+	}
+}
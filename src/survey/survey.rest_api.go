@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -170,3 +171,177 @@ func (r *RESTAPIHandler) DeleteByID(c *fiber.Ctx) error {
 	}
 	return c.JSON(res)
 }
+
+// ReorderQuestions is the REST API handler for `POST /api/v1/surveys/{id}/questions/reorder`.
+func (r *RESTAPIHandler) ReorderQuestions(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := ParamReorderQuestions{}
+	err = grest.NewJSON(c.Body()).ToFlat().Unmarshal(&p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	err = r.UseCase.ReorderQuestions(c.Params("id"), &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.Query.Get("is_skip_return") == "true" {
+		return c.JSON(map[string]any{"message": "Success"})
+	}
+	res, err := r.UseCase.GetByID(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.IsFlat() {
+		return c.JSON(res)
+	}
+	return c.JSON(grest.NewJSON(res).ToStructured().Data)
+}
+
+// Publish is the REST API handler for `POST /api/v1/surveys/{id}/publish`.
+func (r *RESTAPIHandler) Publish(c *fiber.Ctx) error {
+	return r.transition(c, ActionPublish)
+}
+
+// Close is the REST API handler for `POST /api/v1/surveys/{id}/close`.
+func (r *RESTAPIHandler) Close(c *fiber.Ctx) error {
+	return r.transition(c, ActionClose)
+}
+
+// Archive is the REST API handler for `POST /api/v1/surveys/{id}/archive`.
+func (r *RESTAPIHandler) Archive(c *fiber.Ctx) error {
+	return r.transition(c, ActionArchive)
+}
+
+// Reopen is the REST API handler for `POST /api/v1/surveys/{id}/reopen`.
+func (r *RESTAPIHandler) Reopen(c *fiber.Ctx) error {
+	return r.transition(c, ActionReopen)
+}
+
+// Revise is the REST API handler for `POST /api/v1/surveys/{id}/revise`.
+func (r *RESTAPIHandler) Revise(c *fiber.Ctx) error {
+	return r.transition(c, ActionRevise)
+}
+
+// transition applies the lifecycle action to the Survey and returns the Survey data.
+func (r *RESTAPIHandler) transition(c *fiber.Ctx, action string) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := ParamTransition{}
+	err = grest.NewJSON(c.Body()).ToFlat().Unmarshal(&p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	err = r.UseCase.Transition(c.Params("id"), action, &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.Query.Get("is_skip_return") == "true" {
+		return c.JSON(map[string]any{"message": "Success"})
+	}
+	res, err := r.UseCase.GetByID(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.IsFlat() {
+		return c.JSON(res)
+	}
+	return c.JSON(grest.NewJSON(res).ToStructured().Data)
+}
+
+// GetVersions is the REST API handler for `GET /api/v1/surveys/{id}/versions`.
+func (r *RESTAPIHandler) GetVersions(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.GetVersions(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res.SetLink(c)
+	if r.UseCase.IsFlat() {
+		return c.JSON(res)
+	}
+	return c.JSON(grest.NewJSON(res).ToStructured().Data)
+}
+
+// DiffVersions is the REST API handler for `GET /api/v1/surveys/{id}/versions/diff?from={number}&to={number}`.
+func (r *RESTAPIHandler) DiffVersions(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.DiffVersions(c.Params("id"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
+
+// Duplicate is the REST API handler for `POST /api/v1/surveys/{id}/duplicate`.
+func (r *RESTAPIHandler) Duplicate(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := ParamDuplicate{}
+	err = grest.NewJSON(c.Body()).ToFlat().Unmarshal(&p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	id, err := r.UseCase.Duplicate(c.Params("id"), &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.Query.Get("is_skip_return") == "true" {
+		return c.Status(http.StatusCreated).JSON(map[string]any{"message": "Success"})
+	}
+	res, err := r.UseCase.GetByID(id)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	if r.UseCase.IsFlat() {
+		return c.Status(http.StatusCreated).JSON(res)
+	}
+	return c.Status(http.StatusCreated).JSON(grest.NewJSON(res).ToStructured().Data)
+}
+
+// GetDefinition is the REST API handler for `GET /api/v1/surveys/{id}/definition`.
+func (r *RESTAPIHandler) GetDefinition(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.GetDefinition(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
+
+// UpdateDefinition is the REST API handler for `PUT /api/v1/surveys/{id}/definition`, the body is the definition as is.
+func (r *RESTAPIHandler) UpdateDefinition(c *fiber.Ctx) error {
+	err := r.injectDeps(c)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	p := Definition{}
+	err = json.Unmarshal(c.Body(), &p)
+	if err != nil {
+		return app.ErrorHandler(c, app.NewError(http.StatusBadRequest, err.Error()))
+	}
+	err = r.UseCase.UpdateDefinition(c.Params("id"), &p)
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	res, err := r.UseCase.GetDefinition(c.Params("id"))
+	if err != nil {
+		return app.ErrorHandler(c, err)
+	}
+	return c.JSON(res)
+}
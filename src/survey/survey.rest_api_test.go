@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -34,6 +35,13 @@ func prepareTest(tb testing.TB) {
 	app.Server().AddRoute("/surveys/:id", "PUT", REST().UpdateByID, nil)
 	app.Server().AddRoute("/surveys/:id", "PATCH", REST().PartiallyUpdateByID, nil)
 	app.Server().AddRoute("/surveys/:id", "DELETE", REST().DeleteByID, nil)
+	app.Server().AddRoute("/surveys/:id/publish", "POST", REST().Publish, nil)
+	app.Server().AddRoute("/surveys/:id/revise", "POST", REST().Revise, nil)
+	app.Server().AddRoute("/surveys/:id/versions", "GET", REST().GetVersions, nil)
+	app.Server().AddRoute("/surveys/:id/versions/diff", "GET", REST().DiffVersions, nil)
+	app.Server().AddRoute("/surveys/:id/duplicate", "POST", REST().Duplicate, nil)
+	app.Server().AddRoute("/surveys/:id/definition", "GET", REST().GetDefinition, nil)
+	app.Server().AddRoute("/surveys/:id/definition", "PUT", REST().UpdateDefinition, nil)
 }
 
 // getTestSurveyID returns an available Survey ID.
@@ -94,6 +102,50 @@ var tests = []struct {
 		expectedCode: http.StatusOK,
 		expectedBody: `{"name":"Kilo Gram"}`,
 	},
+	{
+		description:  "Partially update Survey with question of another survey",
+		method:       "PATCH",
+		path:         "/surveys/" + getTestSurveyID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Partially Update Survey by ID","questions":[{"id":"` + app.NewNullUUID().String + `","question_text":"Name"}]}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Publish Survey without reason",
+		method:       "POST",
+		path:         "/surveys/" + getTestSurveyID() + "/publish",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Get Survey definition by ID",
+		method:       "GET",
+		path:         "/surveys/" + getTestSurveyID() + "/definition",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"schema_version":1,"translations":{}}`,
+	},
+	{
+		description:  "Update Survey definition with unsupported schema version",
+		method:       "PUT",
+		path:         "/surveys/" + getTestSurveyID() + "/definition",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"schema_version":99,"survey":{"title":"Kilo Gram"},"questions":[{"question_text":"Name","type":"short_text"}]}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Duplicate Survey without translations and quotas",
+		method:       "POST",
+		path:         "/surveys/" + getTestSurveyID() + "/duplicate",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"title":"Kilo Gram Copy","include_translations":false,"include_quotas":false}`,
+		expectedCode: http.StatusCreated,
+		expectedBody: `{"title":"Kilo Gram Copy","status":"draft"}`,
+	},
 	{
 		description:  "Delete Survey by ID",
 		method:       "DELETE",
@@ -145,3 +197,99 @@ func BenchmarkSurveyREST(b *testing.B) {
 		}
 	}
 }
+
+// testRequest performs the request with the full access token, verifies the status code and returns the body response.
+func testRequest(tb testing.TB, method, path, bodyRequest string, expectedCode int, description string) []byte {
+	req := httptest.NewRequest(method, path, strings.NewReader(bodyRequest))
+	req.Header.Add("Authorization", "Bearer "+app.TestFullAccessToken)
+	req.Header.Add("Content-Type", "application/json")
+	res, err := app.Server().Test(req)
+	utils.AssertEqual(tb, nil, err, "app.Server().Test(req)")
+	defer res.Body.Close()
+	utils.AssertEqual(tb, expectedCode, res.StatusCode, description)
+	body, err := io.ReadAll(res.Body)
+	utils.AssertEqual(tb, nil, err, "io.ReadAll(res.Body)")
+	return body
+}
+
+// TestSurveyReviseREST tests the published Survey is revised to draft, edited and published again as the next version.
+func TestSurveyReviseREST(t *testing.T) {
+	prepareTest(t)
+	request := func(method, path, bodyRequest string, expectedCode int, description string) []byte {
+		return testRequest(t, method, path, bodyRequest, expectedCode, description)
+	}
+
+	created := struct {
+		ID        string `json:"id"`
+		Questions []struct {
+			ID string `json:"id"`
+		} `json:"questions"`
+	}{}
+	body := request("POST", "/surveys", `{"title":"Revise","questions":[{"question_text":"Name","type":"short_text","position":1}]}`, http.StatusCreated, "Create Survey")
+	utils.AssertEqual(t, nil, json.Unmarshal(body, &created), "json.Unmarshal(created)")
+	utils.AssertEqual(t, 1, len(created.Questions), "Create Survey with question")
+	path := "/surveys/" + created.ID
+	questions := `{"reason":"Add age","questions":[` +
+		`{"id":"` + created.Questions[0].ID + `","question_text":"Name","type":"short_text","position":1},` +
+		`{"question_text":"Age","type":"number","position":2}]}`
+
+	request("POST", path+"/publish", `{"reason":"First version"}`, http.StatusOK, "Publish Survey")
+	request("PATCH", path, questions, http.StatusBadRequest, "Edit published Survey")
+	body = request("POST", path+"/revise", `{"reason":"Add age"}`, http.StatusOK, "Revise Survey")
+	app.Test().AssertMatchJSONElement(t, []byte(`{"status":"draft"}`), body, "Revise Survey")
+	request("PATCH", path, questions, http.StatusOK, "Edit revised Survey")
+	request("POST", path+"/publish", `{"reason":"Second version"}`, http.StatusOK, "Publish revised Survey")
+
+	body = request("GET", path+"/versions", "", http.StatusOK, "Get Survey versions")
+	app.Test().AssertMatchJSONElement(t, []byte(`{"count":2}`), body, "Get Survey versions")
+
+	diff := VersionDiff{}
+	body = request("GET", path+"/versions/diff?from=1&to=2", "", http.StatusOK, "Diff Survey versions")
+	utils.AssertEqual(t, nil, json.Unmarshal(body, &diff), "json.Unmarshal(diff)")
+	isQuestionAdded := false
+	for _, c := range diff.Changes {
+		if c.Entity == "question" && c.Action == ChangeAdded {
+			isQuestionAdded = true
+		}
+	}
+	utils.AssertEqual(t, true, isQuestionAdded, "Diff Survey versions has the added question")
+}
+
+// TestSurveyDefinitionREST tests the Definition of a Survey applied twice to another Survey keeps the ids of the other Survey.
+func TestSurveyDefinitionREST(t *testing.T) {
+	prepareTest(t)
+
+	type structure struct {
+		ID       string `json:"id"`
+		Sections []struct {
+			ID string `json:"id"`
+		} `json:"sections"`
+		Questions []struct {
+			ID      string `json:"id"`
+			Choices []struct {
+				ID string `json:"id"`
+			} `json:"choices"`
+		} `json:"questions"`
+	}
+	get := func(body []byte, description string) structure {
+		res := structure{}
+		utils.AssertEqual(t, nil, json.Unmarshal(body, &res), description)
+		return res
+	}
+
+	source := get(testRequest(t, "POST", "/surveys", `{"title":"Source","sections":[{"title":"Colors","questions":[`+
+		`{"code":"q_color","question_text":"Favorite color","type":"single_choice","choices":[{"choice_text":"Red"},{"choice_text":"Blue"}]},`+
+		`{"question_text":"Why?","type":"short_text"}]}]}`, http.StatusCreated, "Create source Survey"), "Create source Survey")
+	definition := testRequest(t, "GET", "/surveys/"+source.ID+"/definition", "", http.StatusOK, "Get source Survey definition")
+	target := get(testRequest(t, "POST", "/surveys", `{"title":"Target"}`, http.StatusCreated, "Create target Survey"), "Create target Survey")
+
+	testRequest(t, "PUT", "/surveys/"+target.ID+"/definition", string(definition), http.StatusOK, "Apply the definition to the target Survey")
+	first := get(testRequest(t, "GET", "/surveys/"+target.ID, "", http.StatusOK, "Get target Survey"), "Get target Survey")
+	testRequest(t, "PUT", "/surveys/"+target.ID+"/definition", string(definition), http.StatusOK, "Apply the definition to the target Survey again")
+	second := get(testRequest(t, "GET", "/surveys/"+target.ID, "", http.StatusOK, "Get target Survey again"), "Get target Survey again")
+
+	utils.AssertEqual(t, 1, len(first.Sections), "The target Survey has the section of the definition")
+	utils.AssertEqual(t, 2, len(first.Questions), "The target Survey has the questions of the definition")
+	utils.AssertEqual(t, first, second, "The target Survey keeps its ids")
+	utils.AssertEqual(t, false, first.Questions[0].ID == source.Questions[0].ID, "The target Survey has its own ids")
+}
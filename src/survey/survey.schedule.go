@@ -0,0 +1,122 @@
+package survey
+
+import (
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/survey-app/survey/app"
+)
+
+// IsScheduledOpen reports whether the survey s is scheduled to accept responses at now,
+// which means its opens_at is reached and its closes_at is not.
+func (s Survey) IsScheduledOpen(now time.Time) bool {
+	return s.OpensAt.Valid && !s.OpensAt.Time.After(now) && !s.IsScheduledClosed(now)
+}
+
+// IsScheduledClosed reports whether the closes_at of the survey s is reached at now.
+func (s Survey) IsScheduledClosed(now time.Time) bool {
+	return s.ClosesAt.Valid && !s.ClosesAt.Time.After(now)
+}
+
+// ValidateLimit returns bad request error when the survey s has reached its max_responses.
+// The responses in progress are counted too, so the respondents who already started are able to finish.
+// The survey row is locked until the transaction of ctx ends, so the concurrent requests are counted one after another
+// and the response created in the same transaction is counted by the next request.
+func (s Survey) ValidateLimit(ctx *app.Ctx) error {
+	if !s.MaxResponses.Valid {
+		return nil
+	}
+
+	// prepare db for current ctx
+	tx, err := ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// lock the survey row before counting its responses
+	locked := []string{}
+	err = tx.Table("surveys").Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", s.ID).Pluck("id", &locked).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	count := int64(0)
+	err = tx.Table("responses").Where("survey_id = ? AND deleted_at IS NULL", s.ID).Count(&count).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if count >= s.MaxResponses.Int64 {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("survey_closed"))
+	}
+	return nil
+}
+
+// RunSchedule publishes the draft surveys when their opens_at is reached and closes the published surveys
+// when their closes_at or max_responses is reached, it is registered on the scheduler to run every minute.
+func RunSchedule() {
+	tx, err := app.DB().Conn("main")
+	if err == nil {
+		err = runSchedule(tx, time.Now().UTC())
+	}
+	if err != nil {
+		app.Logger().Error().Err(err).Send()
+	}
+}
+
+// runSchedule applies the scheduled lifecycle actions at now.
+func runSchedule(tx *gorm.DB, now time.Time) error {
+	isChanged := false
+	defer func() {
+		if isChanged {
+			app.Cache().Invalidate(Survey{}.EndPoint())
+		}
+	}()
+
+	// publish the draft surveys within their schedule, the survey without question is left as draft
+	// and the revised survey (published before) is left as draft until it is published again manually
+	ids := []string{}
+	err := tx.Model(&Survey{}).
+		Where("deleted_at IS NULL AND status = ? AND published_at IS NULL AND opens_at <= ?", StatusDraft, now).
+		Where("closes_at IS NULL OR closes_at > ?", now).
+		Where("EXISTS (SELECT 1 FROM questions q WHERE q.survey_id = surveys.id AND q.deleted_at IS NULL)").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		err = tx.Transaction(func(tx *gorm.DB) error {
+			return changeStatus(tx, id, ActionPublish, StatusDraft, StatusPublished, "Scheduled: opens_at is reached.", now)
+		})
+		if err != nil {
+			return err
+		}
+		isChanged = true
+	}
+
+	// close the published surveys which closes_at or max_responses is reached
+	surveys := []Survey{}
+	err = tx.Model(&Survey{}).Select("id", "closes_at").
+		Where("deleted_at IS NULL AND status = ?", StatusPublished).
+		Where("closes_at <= ? OR max_responses <= (SELECT COUNT(*) FROM responses r WHERE r.survey_id = surveys.id AND r.deleted_at IS NULL)", now).
+		Find(&surveys).Error
+	if err != nil {
+		return err
+	}
+	for _, s := range surveys {
+		reason := "Scheduled: max_responses is reached."
+		if s.IsScheduledClosed(now) {
+			reason = "Scheduled: closes_at is reached."
+		}
+		err = tx.Transaction(func(tx *gorm.DB) error {
+			return changeStatus(tx, s.ID.String, ActionClose, StatusPublished, StatusClosed, reason, now)
+		})
+		if err != nil {
+			return err
+		}
+		isChanged = true
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestSurveySchedule(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		opensAt     app.NullDateTime
+		closesAt    app.NullDateTime
+		isOpen      bool
+		isClosed    bool
+	}{
+		{"without schedule", app.NullDateTime{}, app.NullDateTime{}, false, false},
+		{"before opens_at", app.NewNullDateTime(now.Add(time.Hour)), app.NullDateTime{}, false, false},
+		{"at opens_at", app.NewNullDateTime(now), app.NullDateTime{}, true, false},
+		{"within the window", app.NewNullDateTime(now.Add(-time.Hour)), app.NewNullDateTime(now.Add(time.Hour)), true, false},
+		{"at closes_at", app.NewNullDateTime(now.Add(-time.Hour)), app.NewNullDateTime(now), false, true},
+		{"after closes_at without opens_at", app.NullDateTime{}, app.NewNullDateTime(now.Add(-time.Hour)), false, true},
+	}
+	for _, test := range tests {
+		s := Survey{OpensAt: test.opensAt, ClosesAt: test.closesAt}
+		if s.IsScheduledOpen(now) != test.isOpen {
+			t.Errorf("%s: expected scheduled open [%v]", test.description, test.isOpen)
+		}
+		if s.IsScheduledClosed(now) != test.isClosed {
+			t.Errorf("%s: expected scheduled closed [%v]", test.description, test.isClosed)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package survey
+
+// Section returns the section of the survey s with the specified id.
+func (s Survey) Section(id string) (Section, bool) {
+	for _, sec := range s.Sections {
+		if sec.ID.String == id {
+			return sec, true
+		}
+	}
+	return Section{}, false
+}
+
+// HasQuestion reports whether the question with the specified id belongs to the section sec.
+func (sec Section) HasQuestion(id string) bool {
+	for _, q := range sec.Questions {
+		if q.ID.String == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestNextSection(t *testing.T) {
+	s := Survey{}
+	for _, id := range []string{"a", "b", "c"} {
+		sec := Section{}
+		sec.ID.Set(id)
+		s.Sections = append(s.Sections, sec)
+	}
+
+	tests := []struct {
+		description string
+		id          string
+		next        string
+		hasNext     bool
+	}{
+		{"first section", "a", "b", true},
+		{"middle section", "b", "c", true},
+		{"last section", "c", "", false},
+		{"unknown section", "d", "", false},
+	}
+	for _, test := range tests {
+		next, hasNext := s.NextSection(test.id, s.Evaluate(nil))
+		if hasNext != test.hasNext || next.ID.String != test.next {
+			t.Errorf("%s: expected [%v %v], got [%v %v]", test.description, test.next, test.hasNext, next.ID.String, hasNext)
+		}
+	}
+
+	if _, ok := s.Section("b"); !ok {
+		t.Errorf("expected section b to be found")
+	}
+	if _, ok := (Survey{Sections: []Section{{ID: app.NullUUID{}}}}).Section("x"); ok {
+		t.Errorf("expected section x to be not found")
+	}
+}
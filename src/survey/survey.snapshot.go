@@ -0,0 +1,186 @@
+package survey
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/survey-app/survey/app"
+)
+
+// ParseSnapshot returns the Snapshot of the structure, it returns error when the structure is not a valid survey structure.
+func ParseSnapshot(structure app.NullJSON) (Snapshot, error) {
+	sn := Snapshot{}
+	err := structure.Unmarshal(&sn)
+	if err != nil {
+		return sn, err
+	}
+	return sn, sn.validate()
+}
+
+// ValidateStructure returns bad request error when the structure is not a valid survey structure.
+func ValidateStructure(ctx *app.Ctx, structure app.NullJSON) error {
+	_, err := ParseSnapshot(structure)
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("invalid_structure", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	return nil
+}
+
+// validate returns error when the snapshot has no question, or any of its section or question is malformed.
+func (sn Snapshot) validate() error {
+	if len(sn.Questions) == 0 {
+		return errors.New("at least one question is required")
+	}
+	s := sn.Survey()
+	ids := map[string]bool{}
+	for _, sec := range s.Sections {
+		if ids[sec.ID.String] {
+			return errors.New("duplicated id " + strconv.Quote(sec.ID.String))
+		}
+		ids[sec.ID.String] = true
+		_, err := ParseCondition(sec.VisibleIf)
+		if err != nil {
+			return err
+		}
+		_, err = ParseBranches(sec.Branches)
+		if err != nil {
+			return err
+		}
+	}
+	err := validateBranchTargets(s.Sections)
+	if err != nil {
+		return err
+	}
+	codes := map[string]bool{}
+	for _, q := range s.Questions {
+		if ids[q.ID.String] {
+			return errors.New("duplicated id " + strconv.Quote(q.ID.String))
+		}
+		if q.SectionID.Valid && !ids[q.SectionID.String] {
+			return errors.New("unknown section id " + strconv.Quote(q.SectionID.String))
+		}
+		ids[q.ID.String] = true
+		if !IsValidQuestionType(q.Type.String) {
+			return errors.New("unsupported question type " + strconv.Quote(q.Type.String))
+		}
+		if q.Code.Valid {
+			if !IsValidQuestionCode(q.Code.String) || codes[q.Code.String] {
+				return errors.New("invalid or duplicated question code " + strconv.Quote(q.Code.String))
+			}
+			codes[q.Code.String] = true
+		}
+		_, err = ParseCondition(q.VisibleIf)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Survey returns the survey of the snapshot sn along with its sections, questions, choices and rows under the ids of the snapshot,
+// use Clone to get the copy under fresh ids. The question without section id is placed outside of the sections.
+func (sn Snapshot) Survey() Survey {
+	s := Survey{}
+	s.Title.Set(sn.Title)
+	if sn.Description != "" {
+		s.Description.Set(sn.Description)
+	}
+
+	for i, q := range sn.Questions {
+		question := Question{}
+		question.ID.Set(q.ID)
+		if q.ID == "" {
+			question.ID.Set("question." + strconv.Itoa(i+1))
+		}
+		if q.SectionID != "" {
+			question.SectionID.Set(q.SectionID)
+		}
+		if q.Code != "" {
+			question.Code.Set(q.Code)
+		}
+		question.QuestionText.Set(q.QuestionText)
+		question.Type.Set(q.Type)
+		if q.Type == "" {
+			question.Type.Set(QuestionTypeSingleChoice)
+		}
+		question.Config = rawToNullJSON(q.Config)
+		question.IsRequired.Set(q.IsRequired)
+		question.Validation = rawToNullJSON(q.Validation)
+		question.Position.Set(q.Position)
+		if q.Position == 0 {
+			question.Position.Set(int64(i + 1))
+		}
+		question.VisibleIf = rawToNullJSON(q.VisibleIf)
+		if q.BankQuestionID != "" {
+			question.BankQuestionID.Set(q.BankQuestionID)
+		}
+		for j, c := range q.Choices {
+			choise := Choise{}
+			choise.ID.Set(c.ID)
+			if c.ID == "" {
+				choise.ID.Set(question.ID.String + ".choice." + strconv.Itoa(j+1))
+			}
+			choise.QuestionID = question.ID
+			choise.ChoiseText.Set(c.ChoiceText)
+			choise.Position.Set(c.Position)
+			if c.Position == 0 {
+				choise.Position.Set(int64(j + 1))
+			}
+			question.Choises = append(question.Choises, choise)
+		}
+		for j, r := range q.Rows {
+			row := Row{}
+			row.ID.Set(r.ID)
+			if r.ID == "" {
+				row.ID.Set(question.ID.String + ".row." + strconv.Itoa(j+1))
+			}
+			row.QuestionID = question.ID
+			row.RowText.Set(r.RowText)
+			row.Position.Set(r.Position)
+			if r.Position == 0 {
+				row.Position.Set(int64(j + 1))
+			}
+			question.Rows = append(question.Rows, row)
+		}
+		s.Questions = append(s.Questions, question)
+	}
+
+	for i, sec := range sn.Sections {
+		section := Section{}
+		section.ID.Set(sec.ID)
+		if sec.ID == "" {
+			section.ID.Set("section." + strconv.Itoa(i+1))
+		}
+		if sec.Title != "" {
+			section.Title.Set(sec.Title)
+		}
+		if sec.Description != "" {
+			section.Description.Set(sec.Description)
+		}
+		section.Position.Set(sec.Position)
+		if sec.Position == 0 {
+			section.Position.Set(int64(i + 1))
+		}
+		section.VisibleIf = rawToNullJSON(sec.VisibleIf)
+		section.Branches = rawToNullJSON(sec.Branches)
+		for _, q := range s.Questions {
+			if q.SectionID.Valid && q.SectionID.String == sec.ID {
+				section.Questions = append(section.Questions, q)
+			}
+		}
+		s.Sections = append(s.Sections, section)
+	}
+	return s
+}
+
+// rawToNullJSON returns the json value as NullJSON, it returns null when the value is undefined.
+func rawToNullJSON(raw json.RawMessage) app.NullJSON {
+	if len(raw) == 0 {
+		return app.NullJSON{}
+	}
+	return toNullJSON(raw, app.NullJSON{})
+}
@@ -0,0 +1,54 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestParseSnapshot(t *testing.T) {
+	tests := []struct {
+		structure string
+		isValid   bool
+	}{
+		{`{"title":"Empty"}`, false},
+		{`{"questions":[{"question_text":"Name","type":"short_text"}]}`, true},
+		{`{"questions":[{"question_text":"Name","type":"unknown"}]}`, false},
+		{`{"questions":[{"id":"q1","type":"short_text"},{"id":"q1","type":"short_text"}]}`, false},
+		{`{"questions":[{"section_id":"s1","type":"short_text"}]}`, false},
+		{`{"sections":[{"id":"s1"}],"questions":[{"section_id":"s1","type":"short_text"}]}`, true},
+		{`{"questions":[{"code":"a b","type":"short_text"}]}`, false},
+		{`{"questions":[{"code":"q","type":"short_text"},{"code":"q","type":"short_text"}]}`, false},
+		{`{"questions":[{"type":"short_text","visible_if":{"operator":"unknown"}}]}`, false},
+	}
+	for _, tc := range tests {
+		structure := app.NullJSON{}
+		err := json.Unmarshal([]byte(tc.structure), &structure)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ParseSnapshot(structure)
+		if (err == nil) != tc.isValid {
+			t.Errorf("%s: expected valid %v, got error %v", tc.structure, tc.isValid, err)
+		}
+	}
+
+	structure := app.NullJSON{}
+	json.Unmarshal([]byte(`{"title":"Feedback","sections":[{"id":"s1"}],"questions":[{"section_id":"s1","type":"single_choice","choices":[{"choice_text":"Yes"},{"choice_text":"No"}]},{"type":"long_text"}]}`), &structure)
+	sn, err := ParseSnapshot(structure)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := sn.Survey()
+	if s.Title.String != "Feedback" || len(s.Sections) != 1 || len(s.Sections[0].Questions) != 1 || len(s.Questions) != 2 {
+		t.Fatalf("expected the survey with one section of one question and two questions, got %+v", s)
+	}
+	q := s.Questions[0]
+	if q.ID.String != "question.1" || q.Position.Int64 != 1 || len(q.Choises) != 2 || q.Choises[1].ID.String != "question.1.choice.2" || q.Choises[1].Position.Int64 != 2 {
+		t.Errorf("expected the default ids and positions, got %+v", q)
+	}
+	if s.Questions[1].SectionID.Valid {
+		t.Errorf("expected the question without section, got %+v", s.Questions[1])
+	}
+}
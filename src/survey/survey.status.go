@@ -0,0 +1,238 @@
+package survey
+
+import (
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// The lifecycle statuses of the survey, stored on the status column of the surveys table.
+// The survey starts as draft, only the published survey accepts responses and only the draft survey structure is editable,
+// the published or closed survey is revised back to draft to change its structure, then published again as the next version.
+const (
+	StatusDraft     = "draft"
+	StatusPublished = "published"
+	StatusClosed    = "closed"
+	StatusArchived  = "archived"
+)
+
+// The lifecycle actions of the survey.
+const (
+	ActionPublish = "publish"
+	ActionClose   = "close"
+	ActionArchive = "archive"
+	ActionReopen  = "reopen"
+	ActionRevise  = "revise"
+)
+
+// statusTransitions is the allowed statuses before the action along with the status after the action.
+var statusTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	ActionPublish: {[]string{StatusDraft}, StatusPublished},
+	ActionClose:   {[]string{StatusPublished}, StatusClosed},
+	ActionArchive: {[]string{StatusDraft, StatusClosed}, StatusArchived},
+	ActionReopen:  {[]string{StatusClosed, StatusArchived}, StatusPublished},
+	ActionRevise:  {[]string{StatusPublished, StatusClosed}, StatusDraft},
+}
+
+// NextStatus returns the status after the action is applied to the status from, it returns false when the transition is not allowed.
+func NextStatus(from, action string) (string, bool) {
+	t, ok := statusTransitions[action]
+	if !ok {
+		return "", false
+	}
+	for _, f := range t.from {
+		if f == from {
+			return t.to, true
+		}
+	}
+	return "", false
+}
+
+// CurrentStatus returns the status of the survey s, the survey created before the status exists is published when it is active.
+func (s Survey) CurrentStatus() string {
+	if s.Status.Valid {
+		return s.Status.String
+	}
+	if s.IsActive.Bool {
+		return StatusPublished
+	}
+	return StatusDraft
+}
+
+// ValidateOpen returns bad request error when the survey s does not accept responses,
+// either by its status or by its opens_at and closes_at schedule.
+func (s Survey) ValidateOpen(ctx *app.Ctx) error {
+	now := time.Now().UTC()
+	if s.CurrentStatus() == StatusClosed || s.IsScheduledClosed(now) {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("survey_closed"))
+	}
+	if s.CurrentStatus() != StatusPublished {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("survey_not_open", map[string]string{
+			"status": ctx.Trans(s.CurrentStatus()),
+		}))
+	}
+	if s.OpensAt.Valid && s.OpensAt.Time.After(now) {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("survey_not_started", map[string]string{
+			"opens_at": s.OpensAt.Time.Format(time.RFC3339),
+		}))
+	}
+	return nil
+}
+
+// ValidateEditable returns bad request error when the structure (sections, questions and choices) of the survey
+// for the specified ID is not editable anymore, the unknown survey is validated somewhere else.
+func ValidateEditable(ctx *app.Ctx, surveyID string) error {
+	if surveyID == "" {
+		return nil
+	}
+
+	// prepare db for current ctx
+	tx, err := ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	s := Survey{}
+	err = tx.Model(&Survey{}).Select("id", "status", "is_active").Where("id = ?", surveyID).Take(&s).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if s.CurrentStatus() != StatusDraft {
+		return app.NewError(http.StatusBadRequest, ctx.Trans("survey_not_editable", map[string]string{
+			"status": ctx.Trans(s.CurrentStatus()),
+		}))
+	}
+	return nil
+}
+
+// ValidateQuestionEditable returns bad request error when the survey of the question for the specified ID is not editable anymore.
+func ValidateQuestionEditable(ctx *app.Ctx, questionID string) error {
+	if questionID == "" {
+		return nil
+	}
+
+	// prepare db for current ctx
+	tx, err := ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	surveyIDs := []string{}
+	err = tx.Model(&Question{}).Where("id = ? AND survey_id IS NOT NULL", questionID).Pluck("survey_id", &surveyIDs).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	for _, surveyID := range surveyIDs {
+		err = ValidateEditable(ctx, surveyID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changeStatus updates the status of the survey for the specified ID and records the transition,
+// the structure of the survey is stored as the new version when it is published.
+func changeStatus(tx *gorm.DB, surveyID, action, from, to, reason string, now time.Time) error {
+	fields := map[string]any{"status": to, "is_active": to == StatusPublished, "updated_at": now}
+	switch to {
+	case StatusPublished:
+		fields["published_at"] = now
+		fields["closed_at"] = nil
+	case StatusClosed:
+		fields["closed_at"] = now
+	}
+	err := tx.Model(&Survey{}).Where("id = ?", surveyID).Updates(fields).Error
+	if err != nil {
+		return err
+	}
+
+	// store the structure of the published survey as the new version
+	if to == StatusPublished {
+		err = publishVersion(tx, surveyID, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	t := Transition{}
+	t.ID = app.NewNullUUID()
+	t.SurveyID.Set(surveyID)
+	t.Action.Set(action)
+	t.FromStatus.Set(from)
+	t.ToStatus.Set(to)
+	t.Reason.Set(reason)
+	t.CreatedAt.Set(now)
+	return tx.Create(&t).Error
+}
+
+// Transition is the history of the lifecycle status changes of the survey, along with the reason of the change.
+type Transition struct {
+	app.Model
+	ID         app.NullUUID     `json:"id"          db:"t.id"             gorm:"column:id;primaryKey"`
+	SurveyID   app.NullUUID     `json:"survey.id"   db:"t.survey_id,hide" gorm:"column:survey_id"`
+	Action     app.NullString   `json:"action"      db:"t.action"         gorm:"column:action"`
+	FromStatus app.NullString   `json:"from_status" db:"t.from_status"    gorm:"column:from_status"`
+	ToStatus   app.NullString   `json:"to_status"   db:"t.to_status"      gorm:"column:to_status"`
+	Reason     app.NullText     `json:"reason"      db:"t.reason"         gorm:"column:reason"`
+	CreatedAt  app.NullDateTime `json:"created_at"  db:"t.created_at"     gorm:"column:created_at"`
+}
+
+// TableVersion returns the versions of the survey_transitions table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Transition) TableVersion() string {
+	return "26.10.181330"
+}
+
+// TableName returns the name of the survey_transitions table in the database.
+func (Transition) TableName() string {
+	return "survey_transitions"
+}
+
+// TableAliasName returns the table alias name of the survey_transitions table, used for querying.
+func (Transition) TableAliasName() string {
+	return "t"
+}
+
+// GetRelations returns the relations of the survey_transitions data in the database, used for querying.
+func (m *Transition) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the survey_transitions data in the database, used for querying.
+func (m *Transition) GetFilters() []map[string]any {
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the survey_transitions data in the database, used for querying.
+func (m *Transition) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "t.created_at", "direction": "asc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the survey_transitions data in the database, used for querying.
+func (m *Transition) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the survey_transitions schema, used for querying.
+func (m *Transition) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// SeedStatus sets the status of the surveys created before the status exists, the active survey is published.
+func SeedStatus(db *gorm.DB) error {
+	return db.Model(&Survey{}).
+		Where("status IS NULL").
+		Update("status", gorm.Expr("CASE WHEN is_active THEN ? ELSE ? END", StatusPublished, StatusDraft)).Error
+}
@@ -0,0 +1,33 @@
+package survey
+
+import "testing"
+
+func TestNextStatus(t *testing.T) {
+	tests := []struct {
+		from   string
+		action string
+		to     string
+		isOk   bool
+	}{
+		{StatusDraft, ActionPublish, StatusPublished, true},
+		{StatusDraft, ActionClose, "", false},
+		{StatusDraft, ActionArchive, StatusArchived, true},
+		{StatusPublished, ActionPublish, "", false},
+		{StatusPublished, ActionClose, StatusClosed, true},
+		{StatusPublished, ActionArchive, "", false},
+		{StatusClosed, ActionReopen, StatusPublished, true},
+		{StatusClosed, ActionArchive, StatusArchived, true},
+		{StatusArchived, ActionReopen, StatusPublished, true},
+		{StatusPublished, ActionRevise, StatusDraft, true},
+		{StatusClosed, ActionRevise, StatusDraft, true},
+		{StatusDraft, ActionRevise, "", false},
+		{StatusArchived, ActionRevise, "", false},
+		{StatusArchived, "delete", "", false},
+	}
+	for _, test := range tests {
+		to, isOk := NextStatus(test.from, test.action)
+		if to != test.to || isOk != test.isOk {
+			t.Errorf("%s %s: expected [%s %v], got [%s %v]", test.from, test.action, test.to, test.isOk, to, isOk)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package survey
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/survey-app/survey/app"
+)
+
+// HiddenFieldPrefix is the prefix of the placeholder of the hidden field, for example {{hidden.utm_source}}.
+const HiddenFieldPrefix = "hidden."
+
+// codePattern matches the valid question code, the code is used as the placeholder of the answer.
+var codePattern = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+
+// placeholderPattern matches the placeholder of the question text and the choice text, for example {{q_satisfaction}}.
+var placeholderPattern = regexp.MustCompile(`{{\s*([A-Za-z0-9_.\-]+)\s*}}`)
+
+// IsValidQuestionCode reports whether the code can be used as the placeholder of the answer of the question.
+func IsValidQuestionCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Render replaces the placeholders of the text with the values, the unknown placeholder is replaced with empty string.
+func Render(text string, values map[string]string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return values[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+// TemplateValues returns the values of the placeholders, the answers are keyed by the question code and
+// the hidden fields (supplied by the url when the response is started) are keyed by "hidden." + the field name.
+// The answer of the choice question is the text of the selected choices, prefixed by the text of the row for the matrix question
+// and ordered by the rank for the ranking question.
+func (s Survey) TemplateValues(answers map[string][]AnswerValue, hiddenFields app.NullJSON) map[string]string {
+	values := map[string]string{}
+
+	fields := map[string]any{}
+	hiddenFields.Unmarshal(&fields)
+	for k, v := range fields {
+		if v != nil {
+			values[HiddenFieldPrefix+k] = fmt.Sprint(v)
+		}
+	}
+
+	for _, q := range s.Questions {
+		if !q.Code.Valid || len(answers[q.ID.String]) == 0 {
+			continue
+		}
+		texts := []string{}
+		questionAnswers := append([]AnswerValue{}, answers[q.ID.String]...)
+		if q.Type.String == QuestionTypeRanking {
+			sort.SliceStable(questionAnswers, func(i, j int) bool {
+				return questionAnswers[i].Rank < questionAnswers[j].Rank
+			})
+		}
+		for _, a := range questionAnswers {
+			if a.ChoiseID == "" {
+				texts = append(texts, strings.TrimSpace(a.AnswerText))
+				continue
+			}
+			prefix := ""
+			for _, r := range q.Rows {
+				if r.ID.String == a.RowID {
+					prefix = r.RowText.String + ": "
+				}
+			}
+			for _, c := range q.Choises {
+				if c.ID.String == a.ChoiseID {
+					texts = append(texts, prefix+c.ChoiseText.String)
+				}
+			}
+		}
+		values[q.Code.String] = strings.Join(texts, ", ")
+	}
+	return values
+}
+
+// Render returns the copy of the section sec with the placeholders of the question text, the choice text and the row text
+// replaced with the values.
+func (sec Section) Render(values map[string]string) Section {
+	res := sec
+	if sec.Title.Valid {
+		res.Title.Set(Render(sec.Title.String, values))
+	}
+	if sec.Description.Valid {
+		res.Description.Set(Render(sec.Description.String, values))
+	}
+	res.Questions = []Question{}
+	for _, q := range sec.Questions {
+		if q.QuestionText.Valid {
+			q.QuestionText.Set(Render(q.QuestionText.String, values))
+		}
+		choises := []Choise{}
+		for _, c := range q.Choises {
+			if c.ChoiseText.Valid {
+				c.ChoiseText.Set(Render(c.ChoiseText.String, values))
+			}
+			choises = append(choises, c)
+		}
+		q.Choises = choises
+		rows := []Row{}
+		for _, r := range q.Rows {
+			if r.RowText.Valid {
+				r.RowText.Set(Render(r.RowText.String, values))
+			}
+			rows = append(rows, r)
+		}
+		q.Rows = rows
+		res.Questions = append(res.Questions, q)
+	}
+	return res
+}
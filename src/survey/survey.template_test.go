@@ -0,0 +1,50 @@
+package survey
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestRender(t *testing.T) {
+	q1, q2 := Question{}, Question{}
+	q1.ID.Set("q1")
+	q1.Code.Set("q_satisfaction")
+	q2.ID.Set("q2")
+	q2.Code.Set("q_channel")
+	for _, text := range []string{"Email", "Phone"} {
+		c := Choise{}
+		c.ID.Set("q2." + text)
+		c.ChoiseText.Set(text)
+		q2.Choises = append(q2.Choises, c)
+	}
+	s := Survey{Questions: []Question{q1, q2}}
+
+	hiddenFields := app.NullJSON{}
+	err := json.Unmarshal([]byte(`{"utm_source":"newsletter"}`), &hiddenFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := s.TemplateValues(map[string][]AnswerValue{
+		"q1": {{AnswerText: " 4 "}},
+		"q2": {{ChoiseID: "q2.Email"}, {ChoiseID: "q2.Phone"}},
+	}, hiddenFields)
+
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"Why did you rate {{q_satisfaction}} stars?", "Why did you rate 4 stars?"},
+		{"You prefer {{ q_channel }}.", "You prefer Email, Phone."},
+		{"Welcome from {{hidden.utm_source}}", "Welcome from newsletter"},
+		{"Unknown {{q_unknown}}!", "Unknown !"},
+		{"No placeholder", "No placeholder"},
+	}
+	for _, test := range tests {
+		res := Render(test.text, values)
+		if res != test.expected {
+			t.Errorf("%s: expected [%s], got [%s]", test.text, test.expected, res)
+		}
+	}
+}
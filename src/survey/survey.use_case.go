@@ -3,8 +3,12 @@ package survey
 import (
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/survey-app/survey/app"
 )
 
@@ -143,6 +147,18 @@ func (u UseCaseHandler) Create(p *ParamCreate) error {
 		return err
 	}
 
+	// validate the schedule and the response limit
+	err = u.validateSchedule(p.Survey, Survey{})
+	if err != nil {
+		return err
+	}
+
+	// validate the translations of the texts
+	err = ValidateTranslations(u.Ctx, p.Translations)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -189,6 +205,7 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 	if err != nil {
 		return err
 	}
+	p.Ctx = u.Ctx
 
 	// set default value for undefined field
 	err = p.setDefaultValue(old)
@@ -196,6 +213,24 @@ func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
 		return err
 	}
 
+	// validate the schedule and the response limit
+	err = u.validateSchedule(p.Survey, Survey{})
+	if err != nil {
+		return err
+	}
+
+	// validate the translations of the texts
+	err = ValidateTranslations(u.Ctx, p.Translations)
+	if err != nil {
+		return err
+	}
+
+	// upsert the nested sections, questions and choices, all of them are validated before saved
+	err = p.ProcessArray(old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -236,6 +271,7 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 	if err != nil {
 		return err
 	}
+	p.Ctx = u.Ctx
 
 	// set default value for undefined field
 	err = p.setDefaultValue(old)
@@ -243,6 +279,24 @@ func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate)
 		return err
 	}
 
+	// validate the schedule and the response limit
+	err = u.validateSchedule(p.Survey, old)
+	if err != nil {
+		return err
+	}
+
+	// validate the translations of the texts
+	err = ValidateTranslations(u.Ctx, p.Translations)
+	if err != nil {
+		return err
+	}
+
+	// upsert the nested sections, questions and choices, all of them are validated before saved
+	err = p.ProcessArray(old)
+	if err != nil {
+		return err
+	}
+
 	// prepare db for current ctx
 	tx, err := u.Ctx.DB()
 	if err != nil {
@@ -312,60 +366,1063 @@ func (u *UseCaseHandler) setDefaultValue(old Survey) error {
 		u.ID = old.ID
 	}
 
-	if !u.IsActive.Valid {
-		u.IsActive.Set(true)
+	// the status is only changed by the lifecycle actions, the new survey starts as draft
+	if !old.ID.Valid {
+		u.Status.Set(StatusDraft)
+		u.IsActive.Set(false)
+	} else {
+		u.Status = old.Status
+		u.IsActive = old.IsActive
 	}
+	u.PublishedAt = old.PublishedAt
+	u.ClosedAt = old.ClosedAt
+
+	return nil
+}
 
+// validateSchedule returns bad request error when the closes_at is not after the opens_at or the max_responses is not positive,
+// the undefined field is taken from the fallback when the data is partially updated.
+func (u UseCaseHandler) validateSchedule(s Survey, fallback Survey) error {
+	if !s.OpensAt.Valid {
+		s.OpensAt = fallback.OpensAt
+	}
+	if !s.ClosesAt.Valid {
+		s.ClosesAt = fallback.ClosesAt
+	}
+	if s.OpensAt.Valid && s.ClosesAt.Valid && !s.ClosesAt.Time.After(s.OpensAt.Time) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_survey_schedule"))
+	}
+	if s.MaxResponses.Valid && s.MaxResponses.Int64 < 1 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_max_responses"))
+	}
 	return nil
 }
 
+// ProcessArray saves the nested sections, questions and choices of the survey with the diff based upsert.
+// The rows with an id are updated, the rows without an id are inserted and the existing rows missing from the payload
+// are soft deleted, so the ids referenced by the answers are preserved. The undefined (null) array is left untouched.
 func (u *UseCaseHandler) ProcessArray(old Survey) error {
-	tx, err := u.Ctx.DB()
+	return u.processArray(old, nil)
+}
+
+// processArray saves the nested arrays like ProcessArray, the new rows with an id of the new ids are inserted under that id
+// instead of a fresh id, so the ids of the applied Definition are preserved.
+func (u *UseCaseHandler) processArray(old Survey, newIDs map[string]bool) error {
+	if u.Sections == nil && u.Questions == nil {
+		return nil
+	}
+
+	// only the structure of the draft survey is editable
+	err := ValidateEditable(u.Ctx, old.ID.String)
+	if err != nil {
+		return err
+	}
+
+	a, err := u.diffArray(old, newIDs)
+	if err != nil {
+		return err
+	}
+	return a.save(u.Ctx)
+}
+
+// diffArray returns the inserted, updated and deleted rows of the nested arrays of the payload compared to the old survey.
+func (u *UseCaseHandler) diffArray(old Survey, newIDs map[string]bool) (arrayChanges, error) {
+	// the existing sections and questions, along with the section of each question
+	oldSections := map[string]Section{}
+	oldQuestions := map[string]Question{}
+	oldSectionIDs := map[string]app.NullUUID{}
+	for _, sec := range old.Sections {
+		oldSections[sec.ID.String] = sec
+		for _, q := range sec.Questions {
+			oldQuestions[q.ID.String] = q
+			oldSectionIDs[q.ID.String] = sec.ID
+		}
+	}
+	for _, q := range old.Questions {
+		oldQuestions[q.ID.String] = q
+	}
+
+	a := arrayChanges{codes: map[string]bool{}, isDefined: map[string]bool{}, keptSections: map[string]bool{}, keptQuestions: map[string]bool{}, newIDs: newIDs}
+
+	// the questions outside of the sections are untouched when only the sections are defined
+	if u.Questions == nil {
+		for _, q := range old.Questions {
+			if _, ok := oldSectionIDs[q.ID.String]; !ok {
+				a.keep(q)
+			}
+		}
+	}
+
+	// sections one to many
+	for i, s := range u.Sections {
+		section := Section{}
+		section.ID = s.ID
+		_, isExists := oldSections[s.ID.String]
+		if s.ID.Valid && !isExists && old.ID.Valid && !a.newIDs[s.ID.String] {
+			return a, u.invalidArrayReference("sections", s.ID.String)
+		}
+		if !isExists && !a.newIDs[s.ID.String] {
+			section.ID = app.NewNullUUID()
+		}
+		section.SurveyID.Set(u.ID.String)
+		section.Title = s.Title
+		section.Description = s.Description
+		section.Position = s.Position
+		if !section.Position.Valid {
+			section.Position.Set(int64(i + 1))
+		}
+		err := ValidateCondition(u.Ctx, s.VisibleIf)
+		if err != nil {
+			return a, err
+		}
+		section.VisibleIf = s.VisibleIf
+		err = ValidateBranches(u.Ctx, s.Branches)
+		if err != nil {
+			return a, err
+		}
+		section.Branches = s.Branches
+		a.keptSections[section.ID.String] = true
+		if isExists {
+			a.updatedSections = append(a.updatedSections, section)
+		} else {
+			a.sections = append(a.sections, section)
+		}
+
+		// the questions of the existing section are untouched when they are undefined
+		if s.Questions == nil {
+			for _, q := range oldSections[s.ID.String].Questions {
+				a.keep(q)
+			}
+			continue
+		}
+		for _, q := range s.Questions {
+			err := u.upsertQuestion(&a, q, section.ID, oldQuestions, old.ID.Valid)
+			if err != nil {
+				return a, err
+			}
+		}
+	}
+
+	// the branches go to a later section of the payload or end the survey
+	if u.Sections != nil {
+		err := ValidateBranchTargets(u.Ctx, append(append([]Section{}, a.updatedSections...), a.sections...))
+		if err != nil {
+			return a, err
+		}
+	}
+
+	// the questions outside of the sections, the question already defined in the sections is skipped
+	// since the survey questions contain the questions of the sections too
+	for _, q := range u.Questions {
+		if q.ID.Valid && a.isDefined[q.ID.String] {
+			continue
+		}
+		sectionID := app.NullUUID{}
+		if u.Sections == nil {
+			sectionID = oldSectionIDs[q.ID.String]
+		}
+		err := u.upsertQuestion(&a, q, sectionID, oldQuestions, old.ID.Valid)
+		if err != nil {
+			return a, err
+		}
+	}
+
+	// the existing rows missing from the payload
+	if u.Sections != nil {
+		for id := range oldSections {
+			if !a.keptSections[id] {
+				a.deletedSectionIDs = append(a.deletedSectionIDs, id)
+			}
+		}
+	}
+	for id, q := range oldQuestions {
+		if _, isSectioned := oldSectionIDs[id]; !a.keptQuestions[id] && (u.Questions != nil || isSectioned) {
+			a.deletedQuestionIDs = append(a.deletedQuestionIDs, id)
+
+			// the choices and the rows are deleted along with their question
+			for _, c := range q.Choises {
+				a.deletedChoiseIDs = append(a.deletedChoiseIDs, c.ID.String)
+			}
+			for _, r := range q.Rows {
+				a.deletedRowIDs = append(a.deletedRowIDs, r.ID.String)
+			}
+		}
+	}
+	return a, nil
+}
+
+// upsertQuestion validates the question q of the payload and adds it along with its choices and rows to the array changes a.
+// The ids of the payload are ignored when the survey is new (isUpdate is false), otherwise they must refer to the existing rows.
+func (u *UseCaseHandler) upsertQuestion(a *arrayChanges, q Question, sectionID app.NullUUID, oldQuestions map[string]Question, isUpdate bool) error {
+	oldQuestion, isExists := oldQuestions[q.ID.String]
+	if q.ID.Valid && !isExists && isUpdate && !a.newIDs[q.ID.String] {
+		return u.invalidArrayReference("questions", q.ID.String)
+	}
+	a.isDefined[q.ID.String] = true
+
+	// the undefined definition of the question referring to the question bank is taken from the bank question,
+	// the choices and the rows of the bank question are only copied to the new question
+	if q.BankQuestionID.Valid {
+		b, err := GetBankQuestion(u.Ctx, q.BankQuestionID.String)
+		if err != nil {
+			return err
+		}
+		q = b.Apply(q)
+		if !isExists && q.Choises == nil {
+			q.Choises = b.Choises()
+		}
+		if !isExists && q.Rows == nil {
+			q.Rows = b.QuestionRows()
+		}
+	}
+
+	question := Question{}
+	question.ID = q.ID
+	if !isExists && !a.newIDs[q.ID.String] {
+		question.ID = app.NewNullUUID()
+	}
+	question.SurveyID.Set(u.ID.String)
+	question.SectionID = sectionID
+	if q.Code.Valid {
+		if !IsValidQuestionCode(q.Code.String) {
+			return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_question_code", map[string]string{"code": q.Code.String}))
+		}
+		if a.codes[q.Code.String] {
+			return app.NewError(http.StatusBadRequest, u.Ctx.Trans("question_code_duplicated", map[string]string{"code": q.Code.String}))
+		}
+		a.codes[q.Code.String] = true
+		question.Code = q.Code
+	}
+	question.QuestionText.Set(q.QuestionText.String)
+	question.Type = q.Type
+	if !question.Type.Valid {
+		question.Type.Set(QuestionTypeSingleChoice)
+	}
+	if !IsValidQuestionType(question.Type.String) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_question_type", map[string]string{
+			"type":  question.Type.String,
+			"types": strings.Join(QuestionTypes(), ", "),
+		}))
+	}
+	question.Config = q.Config
+	question.IsRequired = q.IsRequired
+	if !question.IsRequired.Valid {
+		question.IsRequired.Set(false)
+	}
+	err := ValidateQuestionValidation(u.Ctx, q.Validation)
+	if err != nil {
+		return err
+	}
+	question.Validation = q.Validation
+	err = ValidateCondition(u.Ctx, q.VisibleIf)
+	if err != nil {
+		return err
+	}
+	question.VisibleIf = q.VisibleIf
+	question.BankQuestionID = q.BankQuestionID
+	question.Position = q.Position
+	if !question.Position.Valid {
+		question.Position.Set(int64(len(a.questions) + len(a.updatedQuestions) + 1))
+	}
+	a.keptQuestions[question.ID.String] = true
+	if isExists {
+		a.updatedQuestions = append(a.updatedQuestions, question)
+	} else {
+		a.questions = append(a.questions, question)
+	}
+
+	// the choices and the rows of the existing question are untouched when they are undefined
+	if !isExists || q.Choises != nil {
+		err = u.upsertChoises(a, question.ID, q.Choises, oldQuestion.Choises, isUpdate)
+		if err != nil {
+			return err
+		}
+	}
+	if !isExists || q.Rows != nil {
+		err = u.upsertRows(a, question.ID, q.Rows, oldQuestion.Rows, isUpdate)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsertChoises adds the choices of the question to the array changes a, the old choices missing from the choices are deleted.
+func (u *UseCaseHandler) upsertChoises(a *arrayChanges, questionID app.NullUUID, choises, oldChoises []Choise, isUpdate bool) error {
+	oldIDs := map[string]bool{}
+	for _, c := range oldChoises {
+		oldIDs[c.ID.String] = true
+	}
+	keptIDs := map[string]bool{}
+	for j, c := range choises {
+		if c.ID.Valid && !oldIDs[c.ID.String] && isUpdate && !a.newIDs[c.ID.String] {
+			return u.invalidArrayReference("choices", c.ID.String)
+		}
+		isChoiseExists := oldIDs[c.ID.String]
+		choise := Choise{}
+		choise.ID = c.ID
+		if !isChoiseExists && !a.newIDs[c.ID.String] {
+			choise.ID = app.NewNullUUID()
+		}
+		choise.QuestionID.Set(questionID.String)
+		choise.ChoiseText.Set(c.ChoiseText.String)
+		choise.Position = c.Position
+		if !choise.Position.Valid {
+			choise.Position.Set(int64(j + 1))
+		}
+		keptIDs[choise.ID.String] = true
+		if isChoiseExists {
+			a.updatedChoises = append(a.updatedChoises, choise)
+		} else {
+			a.choises = append(a.choises, choise)
+		}
+	}
+	for id := range oldIDs {
+		if !keptIDs[id] {
+			a.deletedChoiseIDs = append(a.deletedChoiseIDs, id)
+		}
+	}
+	return nil
+}
+
+// upsertRows adds the rows of the matrix question to the array changes a, the old rows missing from the rows are deleted.
+func (u *UseCaseHandler) upsertRows(a *arrayChanges, questionID app.NullUUID, rows, oldRows []Row, isUpdate bool) error {
+	oldIDs := map[string]bool{}
+	for _, r := range oldRows {
+		oldIDs[r.ID.String] = true
+	}
+	keptIDs := map[string]bool{}
+	for j, r := range rows {
+		if r.ID.Valid && !oldIDs[r.ID.String] && isUpdate && !a.newIDs[r.ID.String] {
+			return u.invalidArrayReference("question_rows", r.ID.String)
+		}
+		isRowExists := oldIDs[r.ID.String]
+		row := Row{}
+		row.ID = r.ID
+		if !isRowExists && !a.newIDs[r.ID.String] {
+			row.ID = app.NewNullUUID()
+		}
+		row.QuestionID.Set(questionID.String)
+		row.RowText.Set(r.RowText.String)
+		row.Position = r.Position
+		if !row.Position.Valid {
+			row.Position.Set(int64(j + 1))
+		}
+		keptIDs[row.ID.String] = true
+		if isRowExists {
+			a.updatedRows = append(a.updatedRows, row)
+		} else {
+			a.rows = append(a.rows, row)
+		}
+	}
+	for id := range oldIDs {
+		if !keptIDs[id] {
+			a.deletedRowIDs = append(a.deletedRowIDs, id)
+		}
+	}
+	return nil
+}
+
+// invalidArrayReference returns bad request error when the id of the nested array does not belong to the survey.
+func (u *UseCaseHandler) invalidArrayReference(entity, id string) error {
+	return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_reference", map[string]string{
+		"entity": u.Ctx.Trans(entity),
+		"key":    u.Ctx.Trans("id"),
+		"value":  id,
+	}))
+}
+
+// arrayChanges holds the inserted, updated and deleted rows of the nested arrays of the survey.
+type arrayChanges struct {
+	codes         map[string]bool // the question codes, to validate the duplicated code
+	isDefined     map[string]bool // the question ids of the payload, to skip the question defined twice
+	keptSections  map[string]bool
+	keptQuestions map[string]bool
+	newIDs        map[string]bool // the ids of the new rows kept as is instead of a fresh id
+
+	sections           []Section
+	updatedSections    []Section
+	deletedSectionIDs  []string
+	questions          []Question
+	updatedQuestions   []Question
+	deletedQuestionIDs []string
+	choises            []Choise
+	updatedChoises     []Choise
+	deletedChoiseIDs   []string
+	rows               []Row
+	updatedRows        []Row
+	deletedRowIDs      []string
+}
+
+// keep marks the existing question q as untouched.
+func (a *arrayChanges) keep(q Question) {
+	a.isDefined[q.ID.String] = true
+	a.keptQuestions[q.ID.String] = true
+	if q.Code.Valid {
+		a.codes[q.Code.String] = true
+	}
+}
+
+// save applies the array changes to the db.
+func (a *arrayChanges) save(ctx *app.Ctx) error {
+	// prepare db for current ctx
+	tx, err := ctx.DB()
 	if err != nil {
 		return app.NewError(http.StatusInternalServerError, err.Error())
 	}
 
-	// questions one to many
-	if len(old.Questions) > 0 {
-		if u.Ctx.Action.Method == "PUT" {
-			//delete old survey data
-			err = tx.Delete(&Question{}, "survey_id = ?", old.ID.String).Error
+	now := time.Now().UTC()
+	for table, ids := range map[string][]string{"sections": a.deletedSectionIDs, "questions": a.deletedQuestionIDs, "choices": a.deletedChoiseIDs, "question_rows": a.deletedRowIDs} {
+		if len(ids) > 0 {
+			err = tx.Table(table).Where("id IN ?", ids).Update("deleted_at", now).Error
 			if err != nil {
-				return err
+				return app.NewError(http.StatusInternalServerError, err.Error())
 			}
 		}
 	}
 
-	if len(u.Questions) > 0 {
-		questions := []Question{}
-		for _, q := range u.Questions {
-			question := Question{}
-			question.ID = app.NewNullUUID()
-			question.SurveyID.Set(u.ID.String)
-			question.QuestionText.Set(q.QuestionText.String)
+	for _, s := range a.updatedSections {
+		err = tx.Model(&Section{}).Where("id = ?", s.ID).
+			Select("title", "description", "position", "visible_if", "branches").Updates(s).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	for _, q := range a.updatedQuestions {
+		err = tx.Model(&Question{}).Where("id = ?", q.ID).
+			Select("section_id", "code", "question_text", "type", "config", "is_required", "validation", "position", "visible_if", "bank_question_id").Updates(q).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	for _, c := range a.updatedChoises {
+		err = tx.Model(&Choise{}).Where("id = ?", c.ID).Select("choise_text", "position").Updates(c).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	for _, r := range a.updatedRows {
+		err = tx.Model(&Row{}).Where("id = ?", r.ID).Select("row_text", "position").Updates(r).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	if len(a.sections) > 0 {
+		err = tx.Create(&a.sections).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	if len(a.questions) > 0 {
+		err = tx.Create(&a.questions).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	if len(a.choises) > 0 {
+		err = tx.Create(&a.choises).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	if len(a.rows) > 0 {
+		err = tx.Create(&a.rows).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// the nested arrays are also cached by their own end points
+	app.Cache().Invalidate("sections")
+	app.Cache().Invalidate("questions")
+	app.Cache().Invalidate("choices")
+	app.Cache().Invalidate("question_rows")
+	return nil
+}
 
-			if len(q.Choises) > 0 {
-				choises := []Choise{}
-				for _, c := range q.Choises {
-					choise := Choise{}
-					choise.ID = app.NewNullUUID()
-					choise.QuestionID.Set(question.ID.String)
-					choise.ChoiseText.Set(c.ChoiseText.String)
+// ReorderQuestions rewrites the position of the questions of the Survey data for the specified ID,
+// the position follows the order of the question ids on the parameters.
+func (u UseCaseHandler) ReorderQuestions(id string, p *ParamReorderQuestions) error {
 
-					choises = append(choises, choise)
-				}
-				err = tx.Create(&choises).Error
-				if err != nil {
-					return app.NewError(http.StatusInternalServerError, err.Error())
-				}
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// the structure of the published survey is not editable
+	err = ValidateEditable(u.Ctx, old.ID.String)
+	if err != nil {
+		return err
+	}
+
+	// the question ids must contain every question of the survey exactly once
+	questionIDs := []string{}
+	for _, q := range old.Questions {
+		questionIDs = append(questionIDs, q.ID.String)
+	}
+	if !IsSameIDs(questionIDs, p.QuestionIDs) {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_reorder", map[string]string{
+			"entity": u.Ctx.Trans("questions"),
+			"parent": u.Ctx.Trans(u.EndPoint()),
+		}))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	for i, questionID := range p.QuestionIDs {
+		err = tx.Model(&Question{}).Where("id = ?", questionID).Update("position", i+1).Error
+		if err != nil {
+			return app.NewError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+	app.Cache().Invalidate(Question{}.TableName())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// Transition applies the lifecycle action to the Survey data for the specified ID and records the transition along with the reason.
+func (u UseCaseHandler) Transition(id, action string, p *ParamTransition) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// validate the transition
+	from := old.CurrentStatus()
+	to, ok := NextStatus(from, action)
+	if !ok {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_status_transition", map[string]string{
+			"action": u.Ctx.Trans(action),
+			"status": u.Ctx.Trans(from),
+		}))
+	}
+	if to == StatusPublished && len(old.Questions) == 0 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("survey_without_question"))
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update the status and record the transition
+	err = changeStatus(tx, old.ID.String, action, from, to, p.Reason.String, time.Now().UTC())
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// IsSameIDs reports whether ids contains every id of expected exactly once, regardless of the order.
+func IsSameIDs(expected, ids []string) bool {
+	if len(expected) != len(ids) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, id := range expected {
+		counts[id]++
+	}
+	for _, id := range ids {
+		counts[id]--
+		if counts[id] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// GetVersions returns the list of the published versions of the Survey data for the specified ID, the latest version comes first.
+func (u UseCaseHandler) GetVersions(id string) (app.ListModel, error) {
+	res := app.ListModel{}
+
+	// get the survey, also check the permission
+	s, err := u.GetByID(id)
+	if err != nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// set pagination info
+	u.Query.Set("survey.id", s.ID.String)
+	res.Count,
+		res.PageContext.Page,
+		res.PageContext.PerPage,
+		res.PageContext.PageCount,
+		err = app.PaginationInfo(tx, &Version{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	// return data count if $per_page set to 0
+	if res.PageContext.PerPage == 0 {
+		return res, err
+	}
+
+	// find data
+	data, err := app.Find(tx, &Version{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	res.SetData(data, u.Query)
+	return res, err
+}
+
+// DiffVersions returns the changes of the structure of the Survey data for the specified ID from the version number from
+// to the version number to.
+func (u UseCaseHandler) DiffVersions(id, from, to string) (VersionDiff, error) {
+	res := VersionDiff{}
+
+	// get the survey, also check the permission
+	s, err := u.GetByID(id)
+	if err != nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// validate the version numbers
+	numbers := []int64{}
+	for _, number := range []string{from, to} {
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return res, app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_version_number", map[string]string{
+				"number": number,
+			}))
+		}
+		numbers = append(numbers, n)
+	}
+	res.From, res.To = numbers[0], numbers[1]
+
+	// get the structure of both versions
+	snapshots := []Snapshot{}
+	for _, n := range numbers {
+		v := Version{}
+		err = tx.Where("survey_id = ? AND number = ?", s.ID, n).Take(&v).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return res, u.Ctx.NotFoundError(err, v.EndPoint(), "number", strconv.FormatInt(n, 10))
 			}
-			questions = append(questions, question)
+			return res, app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		snapshot := Snapshot{}
+		err = v.Structure.Unmarshal(&snapshot)
+		if err != nil {
+			return res, app.NewError(http.StatusInternalServerError, err.Error())
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	res.Changes = Diff(snapshots[0], snapshots[1])
+	return res, nil
+}
+
+// Duplicate creates a draft copy of the Survey data for the specified ID along with its sections, questions, choices and logic,
+// and returns the id of the copy.
+func (u UseCaseHandler) Duplicate(id string, p *ParamDuplicate) (string, error) {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.create")
+	if err != nil {
+		return "", err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return "", err
+	}
+
+	// get the original survey
+	old, err := u.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+
+	return u.CreateCopy(old, *p)
+}
+
+// CreateCopy creates a draft copy of the survey src along with its sections, questions, choices, rows and logic under fresh ids,
+// and returns the id of the copy. The title of src is used when the title of p is undefined, and the translations and the
+// response limit (max_responses) of src are copied unless the include translations or the include quotas of p is false.
+func (u UseCaseHandler) CreateCopy(src Survey, p ParamDuplicate) (string, error) {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.create")
+	if err != nil {
+		return "", err
+	}
+
+	// copy the survey under fresh ids
+	s := src.Clone()
+	if p.Title.Valid {
+		s.Title = p.Title
+	}
+	if p.IncludeTranslations.Valid && !p.IncludeTranslations.Bool {
+		s.Translations = app.NullJSON{}
+	}
+	if p.IncludeQuotas.Valid && !p.IncludeQuotas.Bool {
+		s.MaxResponses = app.NullInt64{}
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// save data to db
+	err = tx.Create(&s).Error
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	a := arrayChanges{sections: s.Sections, questions: s.Questions}
+	for _, q := range s.Questions {
+		a.choises = append(a.choises, q.Choises...)
+		a.rows = append(a.rows, q.Rows...)
+	}
+	err = a.save(u.Ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "duplicate", s.ID.String, src)
+	return s.ID.String, nil
+}
+
+// GetDefinition returns the Definition of the Survey data for the specified ID.
+func (u UseCaseHandler) GetDefinition(id string) (Definition, error) {
+	s, err := u.GetByID(id)
+	if err != nil {
+		return Definition{}, err
+	}
+	return s.Definition(), nil
+}
+
+// UpdateDefinition applies the Definition to the Survey data for the specified ID, the survey along with its settings, translations,
+// sections, questions, choices and rows is replaced by the Definition with the diff based upsert. The id of the Definition is
+// preserved when it is not used by other survey yet, so the same Definition is applied to the other environments under the same ids,
+// and applying the Definition that is already applied changes nothing.
+func (u UseCaseHandler) UpdateDefinition(id string, p *Definition) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("surveys.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = p.validate()
+	if err != nil {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_definition", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the question bank is not portable, the reference to the bank question missing from this environment is removed
+	s := p.ToSurvey()
+	bankIDs := []string{}
+	for _, q := range s.Questions {
+		if q.BankQuestionID.Valid && app.Validator().IsValid(q.BankQuestionID.String, "uuid") {
+			bankIDs = append(bankIDs, q.BankQuestionID.String)
 		}
-		err = tx.Create(&questions).Error
+	}
+	existingBankIDs := []string{}
+	if len(bankIDs) > 0 {
+		err = tx.Table("bank_questions").Where("id IN ? AND deleted_at IS NULL", bankIDs).Pluck("id", &existingBankIDs).Error
 		if err != nil {
 			return app.NewError(http.StatusInternalServerError, err.Error())
 		}
 	}
+	isBankExists := map[string]bool{}
+	for _, bankID := range existingBankIDs {
+		isBankExists[bankID] = true
+	}
+	for i, q := range s.Questions {
+		if !isBankExists[q.BankQuestionID.String] {
+			s.Questions[i].BankQuestionID = app.NullUUID{}
+		}
+	}
+
+	// the survey of the definition under the ids of the survey for the specified ID
+	ids, newIDs, err := definitionIDs(tx, old, s)
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	s.ID = old.ID
+	s.Sections, s.Questions = s.replaceIDs(old.ID, ids)
+	s.Translations = cloneTranslations(s.Translations, ids)
+
+	// the definition that is already applied is not saved again
+	if old.Definition().Equal(s.Definition()) {
+		return nil
+	}
+
+	// validate the schedule and the response limit
+	err = u.validateSchedule(s, Survey{})
+	if err != nil {
+		return err
+	}
+
+	// upsert the nested sections, questions, choices and rows, all of them are validated before saved
+	h := UseCaseHandler{Survey: s, Ctx: u.Ctx, Query: url.Values{}}
+	err = h.processArray(old, newIDs)
+	if err != nil {
+		return err
+	}
 
+	// update data on the db, the undefined settings and translations are cleared
+	err = tx.Model(&Survey{}).Where("id = ?", old.ID).
+		Select("title", "description", "opens_at", "closes_at", "max_responses", "translations").Updates(s).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PUT", "definition", old.ID.String, old)
 	return nil
 }
+
+// definitionIDs returns the ids of the survey s of the Definition applied to the survey old, keyed by the ids of the Definition,
+// along with the ids of the new rows. The rows matched by matchIDs keep the id of the existing rows, so the Definition of
+// another survey (e.g. promoted from another environment) is applied again without any change. The new row keeps the uuid
+// of the Definition unless it is used already, otherwise it gets a fresh id.
+func definitionIDs(tx *gorm.DB, old Survey, s Survey) (map[string]string, map[string]bool, error) {
+	ids := matchIDs(old, s)
+
+	// the ids of the new rows, keyed by the table
+	newRows := map[string][]string{}
+	isNew := func(id string) bool {
+		_, ok := ids[id]
+		return !ok
+	}
+	for _, sec := range s.Sections {
+		if isNew(sec.ID.String) {
+			newRows["sections"] = append(newRows["sections"], sec.ID.String)
+		}
+	}
+	for _, q := range s.Questions {
+		if isNew(q.ID.String) {
+			newRows["questions"] = append(newRows["questions"], q.ID.String)
+		}
+		for _, c := range q.Choises {
+			if isNew(c.ID.String) {
+				newRows["choices"] = append(newRows["choices"], c.ID.String)
+			}
+		}
+		for _, r := range q.Rows {
+			if isNew(r.ID.String) {
+				newRows["question_rows"] = append(newRows["question_rows"], r.ID.String)
+			}
+		}
+	}
+
+	newIDs := map[string]bool{}
+	for table, rowIDs := range newRows {
+		uuids := []string{}
+		for _, id := range rowIDs {
+			if app.Validator().IsValid(id, "uuid") {
+				uuids = append(uuids, id)
+			}
+		}
+		usedIDs := []string{}
+		if len(uuids) > 0 {
+			err := tx.Table(table).Where("id IN ?", uuids).Pluck("id", &usedIDs).Error
+			if err != nil {
+				return ids, newIDs, err
+			}
+		}
+		isUsed := map[string]bool{}
+		for _, id := range usedIDs {
+			isUsed[id] = true
+		}
+		for _, id := range rowIDs {
+			if app.Validator().IsValid(id, "uuid") && !isUsed[id] {
+				newIDs[id] = true
+				continue
+			}
+			ids[id] = app.NewNullUUID().String
+			newIDs[ids[id]] = true
+		}
+	}
+	return ids, newIDs, nil
+}
+
+// matchIDs returns the ids of the existing rows of the survey old matched by the sections, questions, choices and rows
+// of the survey s, keyed by the ids of s. The row is matched by its id first, then by its stable key: the section by its position,
+// the question by its code, then by its position when the code does not differ, and the choice and the row by their position
+// on the matched question. Each existing row is matched once at most, the unmatched row of s is missing from the result.
+func matchIDs(old Survey, s Survey) map[string]string {
+	ids := map[string]string{}
+
+	// the sections by position
+	oldSections, sections := []idPosition{}, []idPosition{}
+	for _, sec := range old.Sections {
+		oldSections = append(oldSections, idPosition{sec.ID.String, sec.Position.Int64})
+	}
+	for _, sec := range s.Sections {
+		sections = append(sections, idPosition{sec.ID.String, sec.Position.Int64})
+	}
+	matchPositions(ids, sections, oldSections)
+
+	// the questions by id, then by code, then by position
+	oldQuestions := map[string]Question{}
+	isMatched := map[string]bool{}
+	for _, q := range old.Questions {
+		oldQuestions[q.ID.String] = q
+	}
+	for _, q := range s.Questions {
+		if _, ok := oldQuestions[q.ID.String]; ok {
+			ids[q.ID.String] = q.ID.String
+			isMatched[q.ID.String] = true
+		}
+	}
+	for _, isByCode := range []bool{true, false} {
+		for _, q := range s.Questions {
+			if _, ok := ids[q.ID.String]; ok {
+				continue
+			}
+			for _, o := range old.Questions {
+				if isMatched[o.ID.String] {
+					continue
+				}
+				isCodeMatched := q.Code.Valid && q.Code.String != "" && o.Code.String == q.Code.String
+				isPositionMatched := !isByCode && q.Position.Int64 == o.Position.Int64 &&
+					(o.Code.String == "" || q.Code.String == "" || o.Code.String == q.Code.String)
+				if isCodeMatched || isPositionMatched {
+					ids[q.ID.String] = o.ID.String
+					isMatched[o.ID.String] = true
+					break
+				}
+			}
+		}
+	}
+
+	// the choices and the rows by position on the matched question
+	for _, q := range s.Questions {
+		o, ok := oldQuestions[ids[q.ID.String]]
+		if !ok {
+			continue
+		}
+		oldChoises, choises := []idPosition{}, []idPosition{}
+		for _, c := range o.Choises {
+			oldChoises = append(oldChoises, idPosition{c.ID.String, c.Position.Int64})
+		}
+		for _, c := range q.Choises {
+			choises = append(choises, idPosition{c.ID.String, c.Position.Int64})
+		}
+		matchPositions(ids, choises, oldChoises)
+		oldRows, rows := []idPosition{}, []idPosition{}
+		for _, r := range o.Rows {
+			oldRows = append(oldRows, idPosition{r.ID.String, r.Position.Int64})
+		}
+		for _, r := range q.Rows {
+			rows = append(rows, idPosition{r.ID.String, r.Position.Int64})
+		}
+		matchPositions(ids, rows, oldRows)
+	}
+	return ids
+}
+
+// idPosition is the id of a row along with its position, the stable key of the section, choice and row.
+type idPosition struct {
+	id       string
+	position int64
+}
+
+// matchPositions adds the ids of the old rows matched by the rows to ids, keyed by the ids of the rows.
+// The row is matched by its id first, then by its position.
+func matchPositions(ids map[string]string, rows, oldRows []idPosition) {
+	isMatched := map[string]bool{}
+	for _, r := range rows {
+		for _, o := range oldRows {
+			if o.id == r.id {
+				ids[r.id] = o.id
+				isMatched[o.id] = true
+				break
+			}
+		}
+	}
+	for _, r := range rows {
+		if _, ok := ids[r.id]; ok {
+			continue
+		}
+		for _, o := range oldRows {
+			if !isMatched[o.id] && o.position == r.position {
+				ids[r.id] = o.id
+				isMatched[o.id] = true
+				break
+			}
+		}
+	}
+}
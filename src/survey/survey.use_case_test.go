@@ -0,0 +1,111 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/survey-app/survey/app"
+)
+
+func TestIsSameIDs(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    []string
+		ids         []string
+		isSame      bool
+	}{
+		{"same order", []string{"a", "b", "c"}, []string{"a", "b", "c"}, true},
+		{"different order", []string{"a", "b", "c"}, []string{"c", "a", "b"}, true},
+		{"missing id", []string{"a", "b", "c"}, []string{"a", "b"}, false},
+		{"unknown id", []string{"a", "b", "c"}, []string{"a", "b", "d"}, false},
+		{"duplicated id", []string{"a", "b", "c"}, []string{"a", "a", "b"}, false},
+	}
+	for _, test := range tests {
+		if IsSameIDs(test.expected, test.ids) != test.isSame {
+			t.Errorf("%s: expected [%v], got [%v]", test.description, test.isSame, !test.isSame)
+		}
+	}
+}
+
+func TestDiffArray(t *testing.T) {
+	question := func(id, text, typ string, choises []Choise, rows []Row) Question {
+		q := Question{Choises: choises, Rows: rows}
+		if id != "" {
+			q.ID.Set(id)
+		}
+		q.QuestionText.Set(text)
+		q.Type.Set(typ)
+		return q
+	}
+	choise := func(id, text string) Choise {
+		c := Choise{}
+		if id != "" {
+			c.ID.Set(id)
+		}
+		c.ChoiseText.Set(text)
+		return c
+	}
+	row := func(id, text string) Row {
+		r := Row{}
+		r.ID.Set(id)
+		r.RowText.Set(text)
+		return r
+	}
+	old := Survey{Questions: []Question{
+		question("q1", "Color", QuestionTypeSingleChoice, []Choise{choise("c1", "Red")}, nil),
+		question("q2", "Rate", QuestionTypeMatrix, []Choise{choise("c2", "Good")}, []Row{row("r2", "Service")}),
+	}}
+	old.ID.Set("survey")
+
+	u := UseCaseHandler{Ctx: &app.Ctx{Lang: "en"}}
+	u.ID.Set("survey")
+	u.Questions = []Question{
+		question("q1", "Favorite color", QuestionTypeSingleChoice, []Choise{choise("c1", "Red"), choise("", "Blue")}, nil),
+		question("", "Name", QuestionTypeShortText, nil, nil),
+	}
+	a, err := u.diffArray(old, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// the question with an id is updated
+	if len(a.updatedQuestions) != 1 || a.updatedQuestions[0].ID.String != "q1" || a.updatedQuestions[0].QuestionText.String != "Favorite color" {
+		t.Errorf("expected the question q1 updated, got %+v", a.updatedQuestions)
+	}
+	if len(a.updatedChoises) != 1 || a.updatedChoises[0].ID.String != "c1" {
+		t.Errorf("expected the choice c1 updated, got %+v", a.updatedChoises)
+	}
+
+	// the question and the choice without an id are inserted under a fresh id
+	if len(a.questions) != 1 || a.questions[0].QuestionText.String != "Name" || !a.questions[0].ID.Valid {
+		t.Errorf("expected the question Name inserted, got %+v", a.questions)
+	}
+	if len(a.choises) != 1 || a.choises[0].ChoiseText.String != "Blue" || a.choises[0].QuestionID.String != "q1" || !a.choises[0].ID.Valid {
+		t.Errorf("expected the choice Blue inserted to q1, got %+v", a.choises)
+	}
+
+	// the question missing from the payload is soft deleted along with its choices and rows
+	if !IsSameIDs([]string{"q2"}, a.deletedQuestionIDs) || !IsSameIDs([]string{"c2"}, a.deletedChoiseIDs) || !IsSameIDs([]string{"r2"}, a.deletedRowIDs) {
+		t.Errorf("expected q2 deleted along with c2 and r2, got %v %v %v", a.deletedQuestionIDs, a.deletedChoiseIDs, a.deletedRowIDs)
+	}
+
+	// the id of another survey is rejected
+	foreignSection := Section{}
+	foreignSection.ID.Set("foreign")
+	foreigns := []struct {
+		description string
+		sections    []Section
+		questions   []Question
+	}{
+		{"question", nil, []Question{question("foreign", "Color", QuestionTypeShortText, nil, nil)}},
+		{"choice", nil, []Question{question("q1", "Color", QuestionTypeSingleChoice, []Choise{choise("foreign", "Red")}, nil)}},
+		{"row", nil, []Question{question("q2", "Rate", QuestionTypeMatrix, nil, []Row{row("foreign", "Service")})}},
+		{"section", []Section{foreignSection}, nil},
+	}
+	for _, foreign := range foreigns {
+		u.Sections, u.Questions = foreign.sections, foreign.questions
+		_, err = u.diffArray(old, nil)
+		if err == nil {
+			t.Errorf("expected error of the foreign %s id, got nil", foreign.description)
+		}
+	}
+}
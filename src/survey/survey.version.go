@@ -0,0 +1,388 @@
+package survey
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// Version is the immutable snapshot of the survey structure, it is stored every time the survey is published with a different structure.
+// The responses are stamped with the version they answered, so the meaning of the past answers is kept when the survey is changed.
+type Version struct {
+	app.Model
+	ID        app.NullUUID     `json:"id"          db:"v.id"             gorm:"column:id;primaryKey"`
+	SurveyID  app.NullUUID     `json:"survey.id"   db:"v.survey_id,hide" gorm:"column:survey_id"`
+	Number    app.NullInt64    `json:"number"      db:"v.number"         gorm:"column:number"`
+	Structure app.NullJSON     `json:"structure"   db:"v.structure"      gorm:"column:structure"`
+	CreatedAt app.NullDateTime `json:"created_at"  db:"v.created_at"     gorm:"column:created_at"`
+}
+
+// EndPoint returns the survey_versions end point, it used for cache key, etc.
+func (Version) EndPoint() string {
+	return "survey_versions"
+}
+
+// TableVersion returns the versions of the survey_versions table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Version) TableVersion() string {
+	return "26.10.181430"
+}
+
+// TableName returns the name of the survey_versions table in the database.
+func (Version) TableName() string {
+	return "survey_versions"
+}
+
+// TableAliasName returns the table alias name of the survey_versions table, used for querying.
+func (Version) TableAliasName() string {
+	return "v"
+}
+
+// GetRelations returns the relations of the survey_versions data in the database, used for querying.
+func (m *Version) GetRelations() map[string]map[string]any {
+	return m.Relations
+}
+
+// GetFilters returns the filter of the survey_versions data in the database, used for querying.
+func (m *Version) GetFilters() []map[string]any {
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the survey_versions data in the database, used for querying.
+func (m *Version) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "v.number", "direction": "desc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the survey_versions data in the database, used for querying.
+func (m *Version) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the survey_versions schema, used for querying.
+func (m *Version) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the survey_versions schema in the open api documentation.
+func (Version) OpenAPISchemaName() string {
+	return "SurveyVersion"
+}
+
+// Snapshot is the structure of the survey stored on the structure column of the survey_versions table.
+type Snapshot struct {
+	Title       string             `json:"title"`
+	Description string             `json:"description,omitempty"`
+	Sections    []SnapshotSection  `json:"sections,omitempty"`
+	Questions   []SnapshotQuestion `json:"questions"`
+}
+
+// SnapshotSection is the section of the Snapshot.
+type SnapshotSection struct {
+	ID          string          `json:"id"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Position    int64           `json:"position"`
+	VisibleIf   json.RawMessage `json:"visible_if,omitempty"`
+	Branches    json.RawMessage `json:"branches,omitempty"`
+}
+
+// SnapshotQuestion is the question of the Snapshot, along with its choices.
+type SnapshotQuestion struct {
+	ID             string           `json:"id"`
+	SectionID      string           `json:"section_id,omitempty"`
+	Code           string           `json:"code,omitempty"`
+	QuestionText   string           `json:"question_text"`
+	Type           string           `json:"type"`
+	Config         json.RawMessage  `json:"config,omitempty"`
+	IsRequired     bool             `json:"is_required"`
+	Validation     json.RawMessage  `json:"validation,omitempty"`
+	Position       int64            `json:"position"`
+	VisibleIf      json.RawMessage  `json:"visible_if,omitempty"`
+	Choices        []SnapshotChoice `json:"choices,omitempty"`
+	Rows           []SnapshotRow    `json:"rows,omitempty"`
+	BankQuestionID string           `json:"bank_question_id,omitempty"`
+}
+
+// SnapshotChoice is the choice of the SnapshotQuestion.
+type SnapshotChoice struct {
+	ID         string `json:"id"`
+	ChoiceText string `json:"choice_text"`
+	Position   int64  `json:"position"`
+}
+
+// SnapshotRow is the row of the matrix SnapshotQuestion.
+type SnapshotRow struct {
+	ID       string `json:"id"`
+	RowText  string `json:"row_text"`
+	Position int64  `json:"position"`
+}
+
+// Snapshot returns the current structure of the survey s, the questions are sorted by position like the survey questions.
+func (s Survey) Snapshot() Snapshot {
+	res := Snapshot{Title: s.Title.String, Description: s.Description.String, Questions: []SnapshotQuestion{}}
+
+	sectionIDs := map[string]string{}
+	for _, sec := range s.Sections {
+		res.Sections = append(res.Sections, SnapshotSection{
+			ID:          sec.ID.String,
+			Title:       sec.Title.String,
+			Description: sec.Description.String,
+			Position:    sec.Position.Int64,
+			VisibleIf:   rawJSON(sec.VisibleIf),
+			Branches:    rawJSON(sec.Branches),
+		})
+		for _, q := range sec.Questions {
+			sectionIDs[q.ID.String] = sec.ID.String
+		}
+	}
+
+	for _, q := range s.Questions {
+		sq := SnapshotQuestion{
+			ID:             q.ID.String,
+			SectionID:      sectionIDs[q.ID.String],
+			Code:           q.Code.String,
+			QuestionText:   q.QuestionText.String,
+			Type:           q.Type.String,
+			Config:         rawJSON(q.Config),
+			IsRequired:     q.IsRequired.Bool,
+			Validation:     rawJSON(q.Validation),
+			Position:       q.Position.Int64,
+			VisibleIf:      rawJSON(q.VisibleIf),
+			BankQuestionID: q.BankQuestionID.String,
+		}
+		for _, c := range q.Choises {
+			sq.Choices = append(sq.Choices, SnapshotChoice{ID: c.ID.String, ChoiceText: c.ChoiseText.String, Position: c.Position.Int64})
+		}
+		for _, r := range q.Rows {
+			sq.Rows = append(sq.Rows, SnapshotRow{ID: r.ID.String, RowText: r.RowText.String, Position: r.Position.Int64})
+		}
+		res.Questions = append(res.Questions, sq)
+	}
+	return res
+}
+
+// rawJSON returns the json value of the NullJSON, it returns nil when the value is null.
+func rawJSON(n app.NullJSON) json.RawMessage {
+	raw := json.RawMessage{}
+	err := n.Unmarshal(&raw)
+	if err != nil || len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return raw
+}
+
+// LatestVersionID returns the id of the latest version of the survey for the specified ID, it is null when the survey is never published.
+func LatestVersionID(tx *gorm.DB, surveyID string) (app.NullUUID, error) {
+	ids := []string{}
+	err := tx.Model(&Version{}).Where("survey_id = ?", surveyID).Order("number desc").Limit(1).Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return app.NullUUID{}, err
+	}
+	id := app.NullUUID{}
+	id.Set(ids[0])
+	return id, nil
+}
+
+// publishVersion stores the structure of the survey for the specified ID as the new version,
+// unless the structure is the same as the latest version.
+func publishVersion(tx *gorm.DB, surveyID string, now time.Time) error {
+	s := Survey{}
+	err := app.First(tx, &s, url.Values{"id": []string{surveyID}})
+	if err != nil {
+		return err
+	}
+	structure, err := json.Marshal(s.Snapshot())
+	if err != nil {
+		return err
+	}
+
+	latest := Version{}
+	err = tx.Where("survey_id = ?", surveyID).Order("number desc").Limit(1).Find(&latest).Error
+	if err != nil {
+		return err
+	}
+	if latest.ID.Valid {
+		prev := Snapshot{}
+		err = latest.Structure.Unmarshal(&prev)
+		if err != nil {
+			return err
+		}
+		b, err := json.Marshal(prev)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(b, structure) {
+			return nil
+		}
+	}
+
+	v := Version{}
+	v.ID = app.NewNullUUID()
+	v.SurveyID.Set(surveyID)
+	v.Number.Set(latest.Number.Int64 + 1)
+	err = json.Unmarshal(structure, &v.Structure)
+	if err != nil {
+		return err
+	}
+	v.CreatedAt.Set(now)
+	return tx.Create(&v).Error
+}
+
+// The actions of the Change between two versions.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Change is the difference of a single section, question, choice or row between two versions.
+type Change struct {
+	Entity   string                 `json:"entity"` // survey, section, question, choice or row
+	ID       string                 `json:"id,omitempty"`
+	ParentID string                 `json:"parent_id,omitempty"` // the question id of the choice and the row
+	Action   string                 `json:"action"`
+	Fields   map[string]FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is the value of the field before and after the change.
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// VersionDiff is the list of the changes from a version to the other version of the survey.
+type VersionDiff struct {
+	From    int64    `json:"from"`
+	To      int64    `json:"to"`
+	Changes []Change `json:"changes"`
+}
+
+// Diff returns the changes of the structure from the snapshot from to the snapshot to,
+// the sections come first, followed by the questions along with their choices and rows.
+func Diff(from, to Snapshot) []Change {
+	res := []Change{}
+	fields := diffFields(
+		Snapshot{Title: from.Title, Description: from.Description},
+		Snapshot{Title: to.Title, Description: to.Description},
+	)
+	if len(fields) > 0 {
+		res = append(res, Change{Entity: "survey", Action: ChangeChanged, Fields: fields})
+	}
+
+	fromSections := map[string]SnapshotSection{}
+	for _, sec := range from.Sections {
+		fromSections[sec.ID] = sec
+	}
+	toSections := map[string]bool{}
+	for _, sec := range to.Sections {
+		toSections[sec.ID] = true
+		old, isExists := fromSections[sec.ID]
+		res = appendChange(res, "section", sec.ID, "", old, sec, isExists)
+	}
+	for _, sec := range from.Sections {
+		if !toSections[sec.ID] {
+			res = append(res, Change{Entity: "section", ID: sec.ID, Action: ChangeRemoved})
+		}
+	}
+
+	fromQuestions := map[string]SnapshotQuestion{}
+	for _, q := range from.Questions {
+		fromQuestions[q.ID] = q
+	}
+	toQuestions := map[string]bool{}
+	for _, q := range to.Questions {
+		toQuestions[q.ID] = true
+		old, isExists := fromQuestions[q.ID]
+		res = appendChange(res, "question", q.ID, "", old.withoutChildren(), q.withoutChildren(), isExists)
+		if !isExists {
+			continue
+		}
+
+		fromChoices := map[string]SnapshotChoice{}
+		for _, c := range old.Choices {
+			fromChoices[c.ID] = c
+		}
+		toChoices := map[string]bool{}
+		for _, c := range q.Choices {
+			toChoices[c.ID] = true
+			oldChoice, isChoiceExists := fromChoices[c.ID]
+			res = appendChange(res, "choice", c.ID, q.ID, oldChoice, c, isChoiceExists)
+		}
+		for _, c := range old.Choices {
+			if !toChoices[c.ID] {
+				res = append(res, Change{Entity: "choice", ID: c.ID, ParentID: q.ID, Action: ChangeRemoved})
+			}
+		}
+
+		fromRows := map[string]SnapshotRow{}
+		for _, r := range old.Rows {
+			fromRows[r.ID] = r
+		}
+		toRows := map[string]bool{}
+		for _, r := range q.Rows {
+			toRows[r.ID] = true
+			oldRow, isRowExists := fromRows[r.ID]
+			res = appendChange(res, "row", r.ID, q.ID, oldRow, r, isRowExists)
+		}
+		for _, r := range old.Rows {
+			if !toRows[r.ID] {
+				res = append(res, Change{Entity: "row", ID: r.ID, ParentID: q.ID, Action: ChangeRemoved})
+			}
+		}
+	}
+	for _, q := range from.Questions {
+		if !toQuestions[q.ID] {
+			res = append(res, Change{Entity: "question", ID: q.ID, Action: ChangeRemoved})
+		}
+	}
+	return res
+}
+
+// withoutChildren returns the question q without its choices and rows, they are compared separately.
+func (q SnapshotQuestion) withoutChildren() SnapshotQuestion {
+	q.Choices = nil
+	q.Rows = nil
+	return q
+}
+
+// appendChange appends the added change when the entity does not exist before, otherwise the changed fields if any.
+func appendChange(changes []Change, entity, id, parentID string, from, to any, isExists bool) []Change {
+	if !isExists {
+		return append(changes, Change{Entity: entity, ID: id, ParentID: parentID, Action: ChangeAdded})
+	}
+	fields := diffFields(from, to)
+	if len(fields) == 0 {
+		return changes
+	}
+	return append(changes, Change{Entity: entity, ID: id, ParentID: parentID, Action: ChangeChanged, Fields: fields})
+}
+
+// diffFields returns the json fields of from and to which values are different.
+func diffFields(from, to any) map[string]FieldChange {
+	f, t := map[string]any{}, map[string]any{}
+	b, _ := json.Marshal(from)
+	json.Unmarshal(b, &f)
+	b, _ = json.Marshal(to)
+	json.Unmarshal(b, &t)
+
+	res := map[string]FieldChange{}
+	for k, v := range t {
+		if !reflect.DeepEqual(f[k], v) {
+			res[k] = FieldChange{From: f[k], To: v}
+		}
+	}
+	for k, v := range f {
+		if _, ok := t[k]; !ok {
+			res[k] = FieldChange{From: v, To: nil}
+		}
+	}
+	return res
+}
@@ -0,0 +1,51 @@
+package survey
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	from := Snapshot{
+		Title: "Feedback",
+		Questions: []SnapshotQuestion{
+			{ID: "q1", QuestionText: "How are you?", Type: "single_choice", Choices: []SnapshotChoice{
+				{ID: "c1", ChoiceText: "Good", Position: 1},
+				{ID: "c2", ChoiceText: "Bad", Position: 2},
+			}},
+			{ID: "q2", QuestionText: "Any comment?", Type: "text"},
+		},
+	}
+	to := Snapshot{
+		Title: "Feedback",
+		Questions: []SnapshotQuestion{
+			{ID: "q1", QuestionText: "How do you feel?", Type: "single_choice", Choices: []SnapshotChoice{
+				{ID: "c1", ChoiceText: "Good", Position: 1},
+				{ID: "c3", ChoiceText: "Okay", Position: 2},
+			}},
+			{ID: "q3", QuestionText: "Your email", Type: "email"},
+		},
+	}
+
+	expected := []Change{
+		{Entity: "question", ID: "q1", Action: ChangeChanged},
+		{Entity: "choice", ID: "c3", ParentID: "q1", Action: ChangeAdded},
+		{Entity: "choice", ID: "c2", ParentID: "q1", Action: ChangeRemoved},
+		{Entity: "question", ID: "q3", Action: ChangeAdded},
+		{Entity: "question", ID: "q2", Action: ChangeRemoved},
+	}
+	changes := Diff(from, to)
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(expected), len(changes), changes)
+	}
+	for i, c := range changes {
+		e := expected[i]
+		if c.Entity != e.Entity || c.ID != e.ID || c.ParentID != e.ParentID || c.Action != e.Action {
+			t.Errorf("change %d: expected %+v, got %+v", i, e, c)
+		}
+	}
+	if f := changes[0].Fields["question_text"]; len(changes[0].Fields) != 1 || f.From != "How are you?" || f.To != "How do you feel?" {
+		t.Errorf("expected only question_text to be changed, got %+v", changes[0].Fields)
+	}
+
+	if changes := Diff(to, to); len(changes) != 0 {
+		t.Errorf("expected no changes on the same version, got %+v", changes)
+	}
+}
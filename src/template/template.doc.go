@@ -0,0 +1,2 @@
+// template is a package related to template data.
+package template
@@ -0,0 +1,113 @@
+package template
+
+import "github.com/survey-app/survey/app"
+
+// Template is the main model of Template data, a reusable survey structure of the template library.
+// It provides a convenient interface for app.ModelInterface
+type Template struct {
+	app.Model
+	ID          app.NullUUID     `json:"id"          db:"m.id"          gorm:"column:id;primaryKey"`
+	Code        app.NullString   `json:"code"        db:"m.code"        gorm:"column:code"`
+	Name        app.NullString   `json:"name"        db:"m.name"        gorm:"column:name"`
+	Category    app.NullString   `json:"category"    db:"m.category"    gorm:"column:category"`
+	Description app.NullText     `json:"description" db:"m.description" gorm:"column:description"`
+	Structure   app.NullJSON     `json:"structure"   db:"m.structure"   gorm:"column:structure"`
+	CreatedAt   app.NullDateTime `json:"created_at"  db:"m.created_at"  gorm:"column:created_at"`
+	UpdatedAt   app.NullDateTime `json:"updated_at"  db:"m.updated_at"  gorm:"column:updated_at"`
+	DeletedAt   app.NullDateTime `json:"deleted_at"  db:"m.deleted_at"  gorm:"column:deleted_at"`
+}
+
+// EndPoint returns the Template end point, it used for cache key, etc.
+func (Template) EndPoint() string {
+	return "survey_templates"
+}
+
+// TableVersion returns the versions of the Template table in the database.
+// Change this value with date format YY.MM.DDHHii when any table structure changes.
+func (Template) TableVersion() string {
+	return "26.10.181500"
+}
+
+// TableName returns the name of the Template table in the database.
+func (Template) TableName() string {
+	return "survey_templates"
+}
+
+// TableAliasName returns the table alias name of the Template table, used for querying.
+func (Template) TableAliasName() string {
+	return "m"
+}
+
+// GetRelations returns the relations of the Template data in the database, used for querying.
+func (m *Template) GetRelations() map[string]map[string]any {
+	// m.AddRelation("left", "users", "cu", []map[string]any{{"column1": "cu.id", "column2": "m.created_by_user_id"}})
+	// m.AddRelation("left", "users", "uu", []map[string]any{{"column1": "uu.id", "column2": "m.updated_by_user_id"}})
+	return m.Relations
+}
+
+// GetFilters returns the filter of the Template data in the database, used for querying.
+func (m *Template) GetFilters() []map[string]any {
+	m.AddFilter(map[string]any{"column1": "m.deleted_at", "operator": "=", "value": nil})
+	return m.Filters
+}
+
+// GetSorts returns the default sort of the Template data in the database, used for querying.
+func (m *Template) GetSorts() []map[string]any {
+	m.AddSort(map[string]any{"column": "m.category", "direction": "asc"})
+	m.AddSort(map[string]any{"column": "m.name", "direction": "asc"})
+	return m.Sorts
+}
+
+// GetFields returns list of the field of the Template data in the database, used for querying.
+func (m *Template) GetFields() map[string]map[string]any {
+	m.SetFields(m)
+	return m.Fields
+}
+
+// GetSchema returns the Template schema, used for querying.
+func (m *Template) GetSchema() map[string]any {
+	return m.SetSchema(m)
+}
+
+// OpenAPISchemaName returns the name of the Template schema in the open api documentation.
+func (Template) OpenAPISchemaName() string {
+	return "SurveyTemplate"
+}
+
+// ParamCreate is the expected parameters for create a new Template data.
+type ParamCreate struct {
+	UseCaseHandler
+}
+
+// ParamUpdate is the expected parameters for update the Template data.
+type ParamUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamPartiallyUpdate is the expected parameters for partially update the Template data.
+type ParamPartiallyUpdate struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamDelete is the expected parameters for delete the Template data.
+type ParamDelete struct {
+	UseCaseHandler
+	Reason app.NullString `json:"reason" gorm:"-" validate:"required"`
+}
+
+// ParamInstantiate is the expected parameters for create a new draft survey from the Template data,
+// the title of the template structure is used when the title is undefined.
+type ParamInstantiate struct {
+	Title app.NullString `json:"title"`
+}
+
+// ParamSaveSurvey is the expected parameters for save the structure of an existing survey as a new Template data,
+// the title of the survey is used when the name is undefined.
+type ParamSaveSurvey struct {
+	Code        app.NullString `json:"code"`
+	Name        app.NullString `json:"name"`
+	Category    app.NullString `json:"category"`
+	Description app.NullText   `json:"description"`
+}
@@ -0,0 +1,156 @@
+package template
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2/utils"
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+)
+
+// prepareTest prepares the test.
+func prepareTest(tb testing.TB) {
+	app.Test()
+	tx := app.Test().Tx
+	app.DB().RegisterTable("main", Template{})
+	app.DB().MigrateTable(tx, "main", app.Setting{})
+	tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&Template{})
+
+	app.Server().AddMiddleware(app.Test().NewCtx([]string{
+		"survey_templates.detail",
+		"survey_templates.list",
+		"survey_templates.create",
+		"survey_templates.edit",
+		"survey_templates.delete",
+	}))
+	app.Server().AddRoute("/survey_templates", "POST", REST().Create, nil)
+	app.Server().AddRoute("/survey_templates", "GET", REST().Get, nil)
+	app.Server().AddRoute("/survey_templates/:id", "GET", REST().GetByID, nil)
+	app.Server().AddRoute("/survey_templates/:id", "PUT", REST().UpdateByID, nil)
+	app.Server().AddRoute("/survey_templates/:id", "PATCH", REST().PartiallyUpdateByID, nil)
+	app.Server().AddRoute("/survey_templates/:id", "DELETE", REST().DeleteByID, nil)
+}
+
+// getTestTemplateID returns an available Template ID.
+func getTestTemplateID() string {
+	return "todo"
+}
+
+// tests is test scenario.
+var tests = []struct {
+	description  string // description of the test case
+	method       string // method to test
+	path         string // route path to test
+	token        string // token to test
+	bodyRequest  string // body to test
+	expectedCode int    // expected HTTP status code
+	expectedBody string // expected body response
+}{
+	{
+		description:  "Get empty list of Template",
+		method:       "GET",
+		path:         "/survey_templates",
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"count":0,"results":[]}`,
+	},
+	{
+		description:  "Create Template with minimum payload",
+		method:       "POST",
+		path:         "/survey_templates",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"name":"Kilogram","structure":{"title":"Kilogram","questions":[{"id":"q1","question_text":"How heavy?","type":"number"}]}}`,
+		expectedCode: http.StatusCreated,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Create Template with unsupported question type",
+		method:       "POST",
+		path:         "/survey_templates",
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"name":"About You","structure":{"title":"About You","questions":[{"id":"q1","question_text":"Name","type":"signature"}]}}`,
+		expectedCode: http.StatusBadRequest,
+		expectedBody: `{"error":{"code":400}}`,
+	},
+	{
+		description:  "Get Template by ID",
+		method:       "GET",
+		path:         "/survey_templates/" + getTestTemplateID(),
+		token:        app.TestFullAccessToken,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilogram"}`,
+	},
+	{
+		description:  "Update Template by ID",
+		method:       "PUT",
+		path:         "/survey_templates/" + getTestTemplateID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Update Template by ID","name":"KG"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"KG"}`,
+	},
+	{
+		description:  "Partially update Template by ID",
+		method:       "PATCH",
+		path:         "/survey_templates/" + getTestTemplateID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Partially Update Template by ID","name":"Kilo Gram"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"name":"Kilo Gram"}`,
+	},
+	{
+		description:  "Delete Template by ID",
+		method:       "DELETE",
+		path:         "/survey_templates/" + getTestTemplateID(),
+		token:        app.TestFullAccessToken,
+		bodyRequest:  `{"reason":"Delete Template by ID"}`,
+		expectedCode: http.StatusOK,
+		expectedBody: `{"code":200}`,
+	},
+}
+
+// TestTemplateREST tests the REST API of Template data with specified scenario.
+func TestTemplateREST(t *testing.T) {
+	prepareTest(t)
+
+	// Iterate through test single test cases
+	for _, test := range tests {
+
+		// Create a new http request with the route from the test case
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+		req.Header.Add("Authorization", "Bearer "+test.token)
+		req.Header.Add("Content-Type", "application/json")
+
+		// Perform the request plain with the app, the second argument is a request latency (set to -1 for no latency)
+		res, err := app.Server().Test(req)
+
+		// Verify if the status code is as expected
+		utils.AssertEqual(t, nil, err, "app.Server().Test(req)")
+		utils.AssertEqual(t, test.expectedCode, res.StatusCode, test.description)
+
+		// Verify if the body response is as expected
+		body, err := io.ReadAll(res.Body)
+		utils.AssertEqual(t, nil, err, "io.ReadAll(res.Body)")
+		app.Test().AssertMatchJSONElement(t, []byte(test.expectedBody), body, test.description)
+		res.Body.Close()
+	}
+}
+
+// BenchmarkTemplateREST tests the REST API of Template data with specified scenario.
+func BenchmarkTemplateREST(b *testing.B) {
+	b.ReportAllocs()
+	prepareTest(b)
+	for i := 0; i < b.N; i++ {
+		for _, test := range tests {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.bodyRequest))
+			req.Header.Add("Authorization", "Bearer "+test.token)
+			req.Header.Add("Content-Type", "application/json")
+			app.Server().Test(req)
+		}
+	}
+}
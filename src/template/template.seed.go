@@ -0,0 +1,237 @@
+package template
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// SeederKey is the key of the Seed on the seeder, the seeder runs once for each key,
+// so change the version of the key when the seeds change to update the templates of the existing db.
+const SeederKey = "survey_templates.v2"
+
+// Seed saves the common templates of the template library, the template which code already exists is updated to the seed.
+func Seed(db *gorm.DB) error {
+	now := time.Now().UTC()
+	for _, t := range seeds() {
+		m, err := t.template()
+		if err != nil {
+			return err
+		}
+
+		old := Template{}
+		err = db.Where("code = ?", t.Code).Limit(1).Find(&old).Error
+		if err != nil {
+			return err
+		}
+		if old.ID.Valid {
+			if t.isEqual(old) {
+				continue
+			}
+			m.UpdatedAt.Set(now)
+			err = db.Model(&Template{}).Where("id = ?", old.ID).
+				Select("name", "category", "description", "structure", "updated_at").Updates(&m).Error
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		m.ID = app.NewNullUUID()
+		m.CreatedAt.Set(now)
+		m.UpdatedAt.Set(now)
+		err = db.Create(&m).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seed is the common template of the template library.
+type seed struct {
+	Code        string
+	Name        string
+	Category    string
+	Description string
+	Structure   survey.Snapshot
+}
+
+// template returns the Template of the seed s, without the id and the timestamps.
+func (s seed) template() (Template, error) {
+	m := Template{}
+	structure, err := json.Marshal(s.Structure)
+	if err != nil {
+		return m, err
+	}
+	m.Code.Set(s.Code)
+	m.Name.Set(s.Name)
+	m.Category.Set(s.Category)
+	m.Description.Set(s.Description)
+	err = json.Unmarshal(structure, &m.Structure)
+	return m, err
+}
+
+// isEqual reports whether the Template m is the same as the seed s, the structure is compared as canonical json
+// since the db may store the json with another key order.
+func (s seed) isEqual(m Template) bool {
+	if m.Name.String != s.Name || m.Category.String != s.Category || m.Description.String != s.Description {
+		return false
+	}
+	expected, err := s.template()
+	if err != nil {
+		return false
+	}
+	var a, b any
+	errA := m.Structure.Unmarshal(&a)
+	errB := expected.Structure.Unmarshal(&b)
+	return errA == nil && errB == nil && reflect.DeepEqual(a, b)
+}
+
+// seeds returns the common templates of the template library.
+func seeds() []seed {
+	return []seed{
+		{
+			Code:        "nps",
+			Name:        "Net Promoter Score (NPS)",
+			Category:    "customer",
+			Description: "Measure the customer loyalty by how likely they recommend you, followed by the reason of the score.",
+			Structure: survey.Snapshot{
+				Title: "How likely are you to recommend us?",
+				Questions: []survey.SnapshotQuestion{
+					{
+						ID:           "score",
+						Code:         "nps_score",
+						QuestionText: "How likely are you to recommend us to a friend or colleague?",
+						Type:         survey.QuestionTypeNPS,
+						Config:       json.RawMessage(`{"scale_min_label":"Not at all likely","scale_max_label":"Extremely likely"}`),
+						IsRequired:   true,
+					},
+					{
+						ID:           "reason",
+						QuestionText: "What is the main reason for your score of {{nps_score}}?",
+						Type:         survey.QuestionTypeLongText,
+					},
+				},
+			},
+		},
+		{
+			Code:        "csat",
+			Name:        "Customer Satisfaction (CSAT)",
+			Category:    "customer",
+			Description: "Measure the customer satisfaction of a product, service or interaction.",
+			Structure: survey.Snapshot{
+				Title: "How satisfied are you?",
+				Questions: []survey.SnapshotQuestion{
+					{
+						ID:           "satisfaction",
+						Code:         "csat_score",
+						QuestionText: "Overall, how satisfied are you with our service?",
+						Type:         survey.QuestionTypeRating,
+						Config:       json.RawMessage(`{"scale_min":1,"scale_max":5,"scale_min_label":"Very unsatisfied","scale_max_label":"Very satisfied"}`),
+						IsRequired:   true,
+					},
+					{
+						ID:           "improvement",
+						QuestionText: "What could we do better?",
+						Type:         survey.QuestionTypeLongText,
+						VisibleIf:    json.RawMessage(`{"operator":"less_than_or_equal","question_id":"satisfaction","value":"3"}`),
+					},
+				},
+			},
+		},
+		{
+			Code:        "employee_engagement",
+			Name:        "Employee Engagement",
+			Category:    "employee",
+			Description: "Understand how engaged, supported and motivated the employees are.",
+			Structure: survey.Snapshot{
+				Title: "Employee Engagement Survey",
+				Sections: []survey.SnapshotSection{
+					{ID: "work", Title: "Your work", Position: 1},
+					{ID: "team", Title: "Your team", Position: 2},
+					{ID: "overall", Title: "Overall", Position: 3},
+				},
+				Questions: []survey.SnapshotQuestion{
+					agreement("meaningful", "work", "My work gives me a sense of personal accomplishment."),
+					agreement("resources", "work", "I have the tools and resources I need to do my job well."),
+					agreement("growth", "work", "I see a path for my growth in this organization."),
+					agreement("recognition", "team", "I receive recognition when I do good work."),
+					agreement("manager", "team", "My manager supports my development."),
+					{
+						ID:           "recommend_workplace",
+						SectionID:    "overall",
+						QuestionText: "How likely are you to recommend this organization as a place to work?",
+						Type:         survey.QuestionTypeNPS,
+						IsRequired:   true,
+					},
+					{
+						ID:           "one_change",
+						SectionID:    "overall",
+						QuestionText: "If you could change one thing about working here, what would it be?",
+						Type:         survey.QuestionTypeLongText,
+					},
+				},
+			},
+		},
+		{
+			Code:        "event_feedback",
+			Name:        "Event Feedback",
+			Category:    "event",
+			Description: "Collect the feedback of the attendees after an event.",
+			Structure: survey.Snapshot{
+				Title: "Event Feedback",
+				Questions: []survey.SnapshotQuestion{
+					{
+						ID:           "overall",
+						QuestionText: "How would you rate the event overall?",
+						Type:         survey.QuestionTypeRating,
+						Config:       json.RawMessage(`{"scale_min":1,"scale_max":5,"scale_min_label":"Poor","scale_max_label":"Excellent"}`),
+						IsRequired:   true,
+					},
+					{
+						ID:           "enjoyed",
+						QuestionText: "What did you enjoy the most?",
+						Type:         survey.QuestionTypeMultipleChoice,
+						Choices: []survey.SnapshotChoice{
+							{ID: "enjoyed.sessions", ChoiceText: "Sessions and speakers"},
+							{ID: "enjoyed.networking", ChoiceText: "Networking"},
+							{ID: "enjoyed.venue", ChoiceText: "Venue"},
+							{ID: "enjoyed.food", ChoiceText: "Food and drinks"},
+						},
+					},
+					{
+						ID:           "attend_again",
+						QuestionText: "Would you attend this event again?",
+						Type:         survey.QuestionTypeSingleChoice,
+						IsRequired:   true,
+						Choices: []survey.SnapshotChoice{
+							{ID: "attend_again.yes", ChoiceText: "Yes"},
+							{ID: "attend_again.maybe", ChoiceText: "Maybe"},
+							{ID: "attend_again.no", ChoiceText: "No"},
+						},
+					},
+					{
+						ID:           "suggestions",
+						QuestionText: "How could we make the next event better?",
+						Type:         survey.QuestionTypeLongText,
+					},
+				},
+			},
+		},
+	}
+}
+
+// agreement returns the required single choice question of the agreement scale.
+func agreement(id, sectionID, text string) survey.SnapshotQuestion {
+	q := survey.SnapshotQuestion{ID: id, SectionID: sectionID, QuestionText: text, Type: survey.QuestionTypeSingleChoice, IsRequired: true}
+	for _, c := range []string{"Strongly disagree", "Disagree", "Neutral", "Agree", "Strongly agree"} {
+		q.Choices = append(q.Choices, survey.SnapshotChoice{ChoiceText: c})
+	}
+	return q
+}
@@ -0,0 +1,57 @@
+package template
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+func TestSeeds(t *testing.T) {
+	codes := map[string]bool{}
+	for _, seed := range seeds() {
+		if codes[seed.Code] {
+			t.Errorf("expected unique code, got duplicated [%s]", seed.Code)
+		}
+		codes[seed.Code] = true
+
+		b, err := json.Marshal(seed.Structure)
+		if err != nil {
+			t.Fatal(err)
+		}
+		structure := app.NullJSON{}
+		err = json.Unmarshal(b, &structure)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sn, err := survey.ParseSnapshot(structure)
+		if err != nil {
+			t.Errorf("expected valid structure of [%s], got error %v", seed.Code, err)
+			continue
+		}
+		m, err := seed.template()
+		if err != nil || !seed.isEqual(m) {
+			t.Errorf("expected the template of [%s] is the same as the seed, got error %v", seed.Code, err)
+		}
+		s := sn.Survey().Clone()
+		if len(s.Questions) != len(seed.Structure.Questions) || len(s.Sections) != len(seed.Structure.Sections) {
+			t.Errorf("expected the survey of [%s] has all of the questions and sections, got %+v", seed.Code, s)
+		}
+	}
+
+	// the template seeded by the older seeds is outdated, e.g. the rating based nps template
+	seed := seeds()[0]
+	old, err := seed.template()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old.Structure = app.NullJSON{}
+	err = json.Unmarshal([]byte(`{"title":"How likely are you to recommend us?","questions":[{"id":"score","question_text":"How likely are you to recommend us?","type":"rating"}]}`), &old.Structure)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed.isEqual(old) {
+		t.Errorf("expected the outdated template of [%s] is not the same as the seed", seed.Code)
+	}
+}
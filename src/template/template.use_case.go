@@ -0,0 +1,451 @@
+package template
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/survey-app/survey/app"
+	"github.com/survey-app/survey/src/survey"
+)
+
+// UseCase returns a UseCaseHandler for expected use case functional.
+func UseCase(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	u := UseCaseHandler{
+		Ctx:   &ctx,
+		Query: url.Values{},
+	}
+	if len(query) > 0 {
+		u.Query = query[0]
+	}
+	return u
+}
+
+// UseCaseHandler provides a convenient interface for Template use case, use UseCase to access UseCaseHandler.
+type UseCaseHandler struct {
+	Template
+
+	// injectable dependencies
+	Ctx   *app.Ctx   `json:"-" db:"-" gorm:"-"`
+	Query url.Values `json:"-" db:"-" gorm:"-"`
+}
+
+// Async return UseCaseHandler with async process.
+func (u UseCaseHandler) Async(ctx app.Ctx, query ...url.Values) UseCaseHandler {
+	ctx.IsAsync = true
+	return UseCase(ctx, query...)
+}
+
+// GetByID returns the Template data for the specified ID.
+func (u UseCaseHandler) GetByID(id string) (Template, error) {
+	res := Template{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.detail")
+	if err != nil {
+		return res, err
+	}
+
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "." + id
+	app.Cache().Get(cacheKey, &res)
+	if res.ID.Valid {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// get from db
+	key := "id"
+	if !app.Validator().IsValid(id, "uuid") {
+		key = "code"
+	}
+	u.Query.Add(key, id)
+	err = app.First(tx, &res, u.Query)
+	if err != nil {
+		return res, u.Ctx.NotFoundError(err, u.EndPoint(), key, id)
+	}
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Get returns the list of Template data.
+func (u UseCaseHandler) Get() (app.ListModel, error) {
+	res := app.ListModel{}
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.list")
+	if err != nil {
+		return res, err
+	}
+	// get from cache and return if exists
+	cacheKey := u.EndPoint() + "?" + u.Query.Encode()
+	err = app.Cache().Get(cacheKey, &res)
+	if err == nil {
+		return res, err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// set pagination info
+	res.Count,
+		res.PageContext.Page,
+		res.PageContext.PerPage,
+		res.PageContext.PageCount,
+		err = app.PaginationInfo(tx, &Template{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	// return data count if $per_page set to 0
+	if res.PageContext.PerPage == 0 {
+		return res, err
+	}
+
+	// find data
+	data, err := app.Find(tx, &Template{}, u.Query)
+	if err != nil {
+		return res, app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	res.SetData(data, u.Query)
+
+	// save to cache and return if exists
+	app.Cache().Set(cacheKey, res)
+	return res, err
+}
+
+// Create creates a new data Template with specified parameters.
+func (u UseCaseHandler) Create(p *ParamCreate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.create")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(Template{})
+	if err != nil {
+		return err
+	}
+
+	// validate the name, the code and the survey structure of the template
+	err = u.validateTemplate(p.Template, Template{})
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// save data to db
+	err = tx.Model(&p).Create(&p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint())
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("POST", "create", p.ID.String, p)
+	return nil
+}
+
+// UpdateByID updates the Template data for the specified ID with specified parameters.
+func (u UseCaseHandler) UpdateByID(id string, p *ParamUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the name, the code and the survey structure of the template
+	err = u.validateTemplate(p.Template, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PUT", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// PartiallyUpdateByID updates the Template data for the specified ID with specified parameters.
+func (u UseCaseHandler) PartiallyUpdateByID(id string, p *ParamPartiallyUpdate) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.edit")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// set default value for undefined field
+	err = p.setDefaultValue(old)
+	if err != nil {
+		return err
+	}
+
+	// validate the name, the code and the survey structure of the template
+	err = u.validateTemplate(p.Template, old)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Updates(p).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("PATCH", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// DeleteByID deletes the Template data for the specified ID.
+func (u UseCaseHandler) DeleteByID(id string, p *ParamDelete) error {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.delete")
+	if err != nil {
+		return err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return err
+	}
+
+	// get previous data
+	old, err := u.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// update data on the db
+	err = tx.Model(&p).Where("id = ?", old.ID).Update("deleted_at", time.Now().UTC()).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	// invalidate cache
+	app.Cache().Invalidate(u.EndPoint(), old.ID.String)
+
+	// save history (user activity), send webhook, etc
+	go u.Ctx.Hook("DELETE", p.Reason.String, old.ID.String, old)
+	return nil
+}
+
+// Instantiate creates a new draft survey from the Template data for the specified ID and returns the id of the survey.
+func (u UseCaseHandler) Instantiate(id string, p *ParamInstantiate) (string, error) {
+
+	// validate param
+	err := u.Ctx.ValidateParam(p)
+	if err != nil {
+		return "", err
+	}
+
+	// get the template, also check the permission
+	t, err := u.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+	sn, err := survey.ParseSnapshot(t.Structure)
+	if err != nil {
+		return "", app.NewError(http.StatusBadRequest, u.Ctx.Trans("invalid_structure", map[string]string{
+			"message": err.Error(),
+		}))
+	}
+	s := sn.Survey()
+	if !s.Title.Valid || s.Title.String == "" {
+		s.Title = t.Name
+	}
+
+	// create the survey under fresh ids
+	return survey.UseCase(*u.Ctx).CreateCopy(s, survey.ParamDuplicate{Title: p.Title})
+}
+
+// SaveSurvey creates a new Template data from the structure of the survey for the specified ID and returns the id of the template.
+func (u UseCaseHandler) SaveSurvey(surveyID string, p *ParamSaveSurvey) (string, error) {
+
+	// check permission
+	err := u.Ctx.ValidatePermission("survey_templates.create")
+	if err != nil {
+		return "", err
+	}
+
+	// validate param
+	err = u.Ctx.ValidateParam(p)
+	if err != nil {
+		return "", err
+	}
+
+	// get the survey along with its sections, questions and choices
+	s, err := survey.UseCase(*u.Ctx).GetByID(surveyID)
+	if err != nil {
+		return "", err
+	}
+
+	// the template of the survey structure
+	t := ParamCreate{}
+	t.Code = p.Code
+	t.Name = p.Name
+	if !t.Name.Valid {
+		t.Name = s.Title
+	}
+	t.Category = p.Category
+	t.Description = p.Description
+	if !t.Description.Valid && s.Description.Valid {
+		t.Description.Set(s.Description.String)
+	}
+	b, err := json.Marshal(s.Snapshot())
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	err = json.Unmarshal(b, &t.Structure)
+	if err != nil {
+		return "", app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	err = u.Create(&t)
+	if err != nil {
+		return "", err
+	}
+	return t.ID.String, nil
+}
+
+// setDefaultValue set default value of undefined field when create or update Template data.
+func (u *UseCaseHandler) setDefaultValue(old Template) error {
+	if !old.ID.Valid {
+		u.ID = app.NewNullUUID()
+	} else {
+		u.ID = old.ID
+	}
+
+	return nil
+}
+
+// validateTemplate validates the required name, the unique code and the survey structure of the template.
+// The undefined field of p will use the value of old.
+func (u UseCaseHandler) validateTemplate(p, old Template) error {
+	name := p.Name
+	if !name.Valid {
+		name = old.Name
+	}
+	if !name.Valid || name.String == "" {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "name"}))
+	}
+
+	// the structure is required when the template is created
+	if !p.Structure.Valid && !old.ID.Valid {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("reference_required", map[string]string{"key": "structure"}))
+	}
+	if p.Structure.Valid {
+		err := survey.ValidateStructure(u.Ctx, p.Structure)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !p.Code.Valid || p.Code.String == old.Code.String {
+		return nil
+	}
+
+	// prepare db for current ctx
+	tx, err := u.Ctx.DB()
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+
+	count := int64(0)
+	err = tx.Model(&Template{}).Where("code = ? AND deleted_at IS NULL", p.Code).Count(&count).Error
+	if err != nil {
+		return app.NewError(http.StatusInternalServerError, err.Error())
+	}
+	if count > 0 {
+		return app.NewError(http.StatusBadRequest, u.Ctx.Trans("template_code_duplicated", map[string]string{"code": p.Code.String}))
+	}
+	return nil
+}